@@ -0,0 +1,207 @@
+package xrunes
+
+import "unicode"
+
+// DetectCase reports the case style of s. Words are separated by '_', '-' or
+// '.', and by camel humps when there is no separator. A single word matches
+// several styles and is reported as the first that applies: a lowercase word
+// is CaseSnake, an uppercase word is CaseScreamingSnake and a capitalized word
+// is CasePascal. Identifiers that combine separators or cases, such as
+// "user_Name", are CaseMixed, while empty input, leading, trailing or repeated
+// separators and any other rune, such as a space, give CaseUnknown.
+//
+// Example:
+//
+//	DetectCase([]rune("max_conns"))    // CaseSnake
+//	DetectCase([]rune("Content-Type")) // CaseTrain
+//	DetectCase([]rune("userID"))       // CaseCamel
+//	DetectCase([]rune("user_Name"))    // CaseMixed
+func DetectCase(s []rune) CaseStyle {
+	if len(s) == 0 {
+		return CaseUnknown
+	}
+
+	sep := rune(0)
+	upper, lower := false, false
+	// capitalized is true while every word starts with an uppercase letter
+	// followed only by lowercase letters and digits.
+	capitalized := true
+	wordStart := true
+	for i, r := range s {
+		switch {
+		case r == '_' || r == '-' || r == '.':
+			if i == 0 || i == len(s)-1 || wordStart {
+				return CaseUnknown
+			}
+
+			if sep != 0 && sep != r {
+				return CaseMixed
+			}
+
+			sep = r
+			wordStart = true
+			continue
+		case unicode.IsUpper(r):
+			upper = true
+			if !wordStart {
+				capitalized = false
+			}
+		case unicode.IsLetter(r):
+			lower = true
+			if wordStart {
+				capitalized = false
+			}
+		case unicode.IsNumber(r):
+		default:
+			return CaseUnknown
+		}
+
+		wordStart = false
+	}
+
+	switch sep {
+	case '_':
+		switch {
+		case !upper:
+			return CaseSnake
+		case !lower:
+			return CaseScreamingSnake
+		}
+	case '-':
+		switch {
+		case !upper:
+			return CaseKebab
+		case capitalized:
+			return CaseTrain
+		}
+	case '.':
+		if !upper {
+			return CaseDot
+		}
+	default:
+		first := s[0]
+		for _, r := range s {
+			if unicode.IsLetter(r) {
+				first = r
+				break
+			}
+		}
+
+		switch {
+		case !upper:
+			return CaseSnake
+		case !lower:
+			return CaseScreamingSnake
+		case unicode.IsUpper(first):
+			return CasePascal
+		default:
+			return CaseCamel
+		}
+	}
+
+	return CaseMixed
+}
+
+// Convert converts s to the case style to. The words of s are split on the
+// same boundaries as Underscore, with '.' and every other rune that is not a
+// letter or a number treated as a separator. PreserveCase and Screaming apply
+// to CaseSnake, CaseKebab and CaseDot, PreserveCase to CaseTrain, and
+// SplitAcronyms to every style.
+// CaseUnknown and CaseMixed return a copy of s.
+//
+// For inputs whose words start with a letter, have at least two runes and do
+// not end with a digit, converting is lossless across styles:
+// Convert(Convert(s, a), b) equals Convert(s, b) and DetectCase(Convert(s, a))
+// is a for identifiers of two words or more.
+//
+// Example:
+//
+//	Convert([]rune("userName"), CaseSnake)                // "user_name"
+//	Convert([]rune("content_type"), CaseTrain)            // "Content-Type"
+//	Convert([]rune("HTTPServer"), CaseDot)                // "httpserver"
+//	Convert([]rune("HTTPServer"), CaseDot, SplitAcronyms) // "http.server"
+//	Convert([]rune("max-conns"), CaseSnake, Screaming)    // "MAX_CONNS"
+func Convert(s []rune, to CaseStyle, options ...HyphenMinusOption) []rune {
+	params := &HyphenMinusParams{}
+	for _, option := range options {
+		option(params)
+	}
+
+	sep := rune(0)
+	switch to {
+	case CaseSnake:
+		sep = '_'
+	case CaseScreamingSnake:
+		sep = '_'
+		params.Screaming = true
+	case CaseKebab, CaseTrain:
+		sep = '-'
+	case CaseDot:
+		sep = '.'
+	case CaseCamel, CasePascal:
+	default:
+		return append([]rune{}, s...)
+	}
+
+	sb := make([]rune, 0, len(s)+4)
+	for i, word := range caseWords(s, params.SplitAcronyms) {
+		if i > 0 && sep != 0 {
+			sb = append(sb, sep)
+		}
+
+		for j, r := range word {
+			switch {
+			case to == CaseCamel && i == 0:
+				r = unicode.ToLower(r)
+			case to == CaseCamel || to == CasePascal || to == CaseTrain && !params.PreserveCase:
+				if j == 0 {
+					r = unicode.ToUpper(r)
+				} else {
+					r = unicode.ToLower(r)
+				}
+			case to == CaseTrain || params.PreserveCase && !params.Screaming:
+			case params.Screaming:
+				r = unicode.ToUpper(r)
+			default:
+				r = unicode.ToLower(r)
+			}
+
+			sb = append(sb, r)
+		}
+	}
+
+	return sb
+}
+
+// caseWords splits s into words with their case preserved, ending acronyms
+// before a following capitalized word when splitAcronyms is set.
+func caseWords(s []rune, splitAcronyms bool) [][]rune {
+	normalized := make([]rune, len(s))
+	for i, r := range s {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			normalized[i] = r
+		} else {
+			normalized[i] = '_'
+		}
+	}
+
+	options := []HyphenMinusOption{PreserveCase}
+	if splitAcronyms {
+		options = append(options, SplitAcronyms)
+	}
+
+	u := Underscore(normalized, options...)
+	words := make([][]rune, 0, 4)
+	start := 0
+	for i := 0; i <= len(u); i++ {
+		if i == len(u) || u[i] == '_' {
+			if i > start {
+				words = append(words, u[start:i])
+			}
+
+			start = i + 1
+		}
+	}
+
+	return words
+}
@@ -0,0 +1,146 @@
+package xrunes_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+var caseStyles = []runes.CaseStyle{
+	runes.CaseSnake,
+	runes.CaseScreamingSnake,
+	runes.CaseKebab,
+	runes.CaseCamel,
+	runes.CasePascal,
+	runes.CaseTrain,
+	runes.CaseDot,
+}
+
+func TestDetectCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected runes.CaseStyle
+	}{
+		{"max_conns", runes.CaseSnake},
+		{"MAX_CONNS", runes.CaseScreamingSnake},
+		{"max-conns", runes.CaseKebab},
+		{"maxConns", runes.CaseCamel},
+		{"userID", runes.CaseCamel},
+		{"MaxConns", runes.CasePascal},
+		{"HTTPServer", runes.CasePascal},
+		{"Content-Type", runes.CaseTrain},
+		{"X-Request-ID2", runes.CaseMixed},
+		{"app.max.conns", runes.CaseDot},
+		{"version2_update", runes.CaseSnake},
+		{"user", runes.CaseSnake},
+		{"HTTP", runes.CaseScreamingSnake},
+		{"User", runes.CasePascal},
+		{"2fa", runes.CaseSnake},
+		{"user_Name", runes.CaseMixed},
+		{"user-name_id", runes.CaseMixed},
+		{"App.Name", runes.CaseMixed},
+		{"Content-type", runes.CaseMixed},
+		{"", runes.CaseUnknown},
+		{"_private", runes.CaseUnknown},
+		{"trailing-", runes.CaseUnknown},
+		{"a__b", runes.CaseUnknown},
+		{"hello world", runes.CaseUnknown},
+		{"crème_brûlée", runes.CaseSnake},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, runes.DetectCase([]rune(tt.input)), tt.input)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		input    string
+		to       runes.CaseStyle
+		options  []runes.HyphenMinusOption
+		expected string
+	}{
+		{"userName", runes.CaseSnake, nil, "user_name"},
+		{"userName", runes.CaseScreamingSnake, nil, "USER_NAME"},
+		{"userName", runes.CaseKebab, nil, "user-name"},
+		{"user_name", runes.CaseCamel, nil, "userName"},
+		{"user_name", runes.CasePascal, nil, "UserName"},
+		{"content_type", runes.CaseTrain, nil, "Content-Type"},
+		{"HTTPServer", runes.CaseDot, nil, "httpserver"},
+		{"HTTPServer", runes.CaseDot, []runes.HyphenMinusOption{runes.SplitAcronyms}, "http.server"},
+		{"HTTPServer", runes.CaseCamel, []runes.HyphenMinusOption{runes.SplitAcronyms}, "httpServer"},
+		{"app.max.conns", runes.CaseSnake, nil, "app_max_conns"},
+		{"XMLHttpRequest", runes.CaseTrain, nil, "Xmlhttp-Request"},
+		{"XMLHttpRequest", runes.CaseTrain, []runes.HyphenMinusOption{runes.SplitAcronyms}, "Xml-Http-Request"},
+		{"XMLHttpRequest", runes.CaseTrain, []runes.HyphenMinusOption{runes.SplitAcronyms, runes.PreserveCase}, "XML-Http-Request"},
+		{"max-conns", runes.CaseSnake, []runes.HyphenMinusOption{runes.Screaming}, "MAX_CONNS"},
+		{"max-conns", runes.CaseKebab, []runes.HyphenMinusOption{runes.Screaming}, "MAX-CONNS"},
+		{"maxConns", runes.CaseDot, []runes.HyphenMinusOption{runes.PreserveCase}, "max.Conns"},
+		{"  hello world  ", runes.CaseCamel, nil, "helloWorld"},
+		{"user_Name", runes.CaseMixed, nil, "user_Name"},
+		{"user_Name", runes.CaseUnknown, nil, "user_Name"},
+		{"", runes.CaseSnake, nil, ""},
+	}
+
+	for _, tt := range tests {
+		result := runes.Convert([]rune(tt.input), tt.to, tt.options...)
+		assert.Equal(t, tt.expected, string(result), "%s to %s", tt.input, tt.to)
+	}
+}
+
+func TestConvertMatchesTransforms(t *testing.T) {
+	for _, s := range []string{"userName", "HTTPServerURL", "hello world", "Hello123 World456", "XMLHttpRequest"} {
+		input := []rune(s)
+		assert.Equal(t, string(runes.Underscore(input)), string(runes.Convert(input, runes.CaseSnake)), s)
+		assert.Equal(t, string(runes.Underscore(input, runes.Screaming)), string(runes.Convert(input, runes.CaseScreamingSnake)), s)
+		assert.Equal(t, string(runes.Dasherize(input)), string(runes.Convert(input, runes.CaseKebab)), s)
+		assert.Equal(t, string(runes.CamelCase(input)), string(runes.Convert(input, runes.CaseCamel)), s)
+		assert.Equal(t, string(runes.PascalCase(input)), string(runes.Convert(input, runes.CasePascal)), s)
+		assert.Equal(t, string(runes.Underscore(input, runes.SplitAcronyms)), string(runes.Convert(input, runes.CaseSnake, runes.SplitAcronyms)), s)
+		assert.Equal(t, string(runes.Dasherize(input, runes.SplitAcronyms)), string(runes.Convert(input, runes.CaseKebab, runes.SplitAcronyms)), s)
+	}
+}
+
+// wellFormed returns an identifier of n words that start and end with a
+// letter and have at least two runes, in the given style.
+func wellFormed(rng *rand.Rand, n int, style runes.CaseStyle) []rune {
+	letters := []rune("abcdefghijklmnopqrstuvwxyzéøπ")
+	inner := []rune("abcdefghijklmnopqrstuvwxyzéøπ0123456789")
+	words := make([]rune, 0)
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			words = append(words, ' ')
+		}
+
+		words = append(words, letters[rng.IntN(len(letters))])
+		for j := rng.IntN(6); j > 0; j-- {
+			words = append(words, inner[rng.IntN(len(inner))])
+		}
+
+		words = append(words, letters[rng.IntN(len(letters))])
+	}
+
+	return runes.Convert(words, style)
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 500 {
+		n := 1 + rng.IntN(4)
+		from := caseStyles[rng.IntN(len(caseStyles))]
+		x := wellFormed(rng, n, from)
+		for _, a := range caseStyles {
+			converted := runes.Convert(x, a)
+			if n > 1 {
+				assert.Equal(t, a, runes.DetectCase(converted), "%s as %s", string(x), a)
+			}
+
+			for _, b := range caseStyles {
+				assert.Equal(t, string(runes.Convert(x, b)), string(runes.Convert(converted, b)),
+					"%s through %s to %s", string(x), a, b)
+			}
+		}
+	}
+}
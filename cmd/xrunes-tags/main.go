@@ -0,0 +1,401 @@
+// Command xrunes-tags adds or rewrites the struct tags of Go source files with
+// names derived from the field names by an xrunes case transform.
+//
+// Usage:
+//
+//	xrunes-tags [flags] [file.go ...]
+//
+// The flags are:
+//
+//	-tags list
+//		comma-separated tag keys to manage, each optionally followed by
+//		":style" to override -case, such as "json,yaml,env:screaming-snake"
+//		(default "json")
+//	-case style
+//		case style of the names: snake, screaming-snake, kebab, camel,
+//		pascal, train or dot (default "snake")
+//	-type list
+//		comma-separated names of the struct types to change (default all)
+//	-overwrite
+//		rewrite the names of existing tags, keeping their options such as
+//		",omitempty"; by default only missing tags are added
+//	-dry-run
+//		print a unified diff of the changes instead of writing the files
+//
+// When no file is given, the file named by $GOFILE is used, so the command can
+// be run with a directive such as:
+//
+//	//go:generate xrunes-tags -tags json,db -case snake
+//
+// Field names are converted with the xrunes.SplitAcronyms option, since Go
+// names spell initialisms in capitals: HTTPProxy becomes "http_proxy". Only
+// exported, named fields are tagged, and a tag whose name is "-" is never
+// changed. Fields declared together, such as "A, B string", share one tag, so
+// they are left as they are and reported on standard error. Running the command twice with the same flags changes nothing the
+// second time.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+)
+
+// config holds the parsed command-line flags.
+type config struct {
+	tags      []tagSpec
+	types     []string
+	overwrite bool
+	dryRun    bool
+}
+
+// tagSpec is a managed tag key and the case style of its names.
+type tagSpec struct {
+	key   string
+	style xrunes.CaseStyle
+}
+
+var styles = map[string]xrunes.CaseStyle{
+	"snake":           xrunes.CaseSnake,
+	"screaming-snake": xrunes.CaseScreamingSnake,
+	"kebab":           xrunes.CaseKebab,
+	"camel":           xrunes.CaseCamel,
+	"pascal":          xrunes.CasePascal,
+	"train":           xrunes.CaseTrain,
+	"dot":             xrunes.CaseDot,
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "xrunes-tags:", err)
+		}
+
+		os.Exit(2)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("xrunes-tags", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	tags := flags.String("tags", "json", "comma-separated tag keys, each optionally followed by :style")
+	style := flags.String("case", "snake", "case style: snake, screaming-snake, kebab, camel, pascal, train or dot")
+	types := flags.String("type", "", "comma-separated struct type names to change (default all)")
+	overwrite := flags.Bool("overwrite", false, "rewrite the names of existing tags")
+	dryRun := flags.Bool("dry-run", false, "print a unified diff instead of writing the files")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := parseConfig(*tags, *style, *types)
+	if err != nil {
+		return err
+	}
+
+	cfg.overwrite = *overwrite
+	cfg.dryRun = *dryRun
+	files := flags.Args()
+	if len(files) == 0 {
+		if gofile := os.Getenv("GOFILE"); gofile != "" {
+			files = []string{gofile}
+		} else {
+			return errors.New("no files given and $GOFILE is not set")
+		}
+	}
+
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		out, err := rewrite(file, src, cfg, stderr)
+		if err != nil {
+			return err
+		}
+
+		if bytes.Equal(src, out) {
+			continue
+		}
+
+		if cfg.dryRun {
+			edits := []xrunes.Edit{
+				{Op: xrunes.EditDelete, Runes: []rune(string(src))},
+				{Op: xrunes.EditInsert, Runes: []rune(string(out))},
+			}
+
+			fmt.Fprintf(stdout, "--- %s\n+++ %s\n%s", file, file, string(xrunes.FormatUnified(edits, 3)))
+			continue
+		}
+
+		if err := os.WriteFile(file, out, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseConfig(tags, style, types string) (config, error) {
+	var cfg config
+	def, ok := styles[style]
+	if !ok {
+		return cfg, fmt.Errorf("unknown case style %q", style)
+	}
+
+	for _, tag := range strings.Split(tags, ",") {
+		key, name, found := strings.Cut(strings.TrimSpace(tag), ":")
+		if key == "" {
+			continue
+		}
+
+		spec := tagSpec{key: key, style: def}
+		if found {
+			if spec.style, ok = styles[name]; !ok {
+				return cfg, fmt.Errorf("unknown case style %q for tag %q", name, key)
+			}
+		}
+
+		cfg.tags = append(cfg.tags, spec)
+	}
+
+	if len(cfg.tags) == 0 {
+		return cfg, errors.New("no tags given")
+	}
+
+	for _, name := range strings.Split(types, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			cfg.types = append(cfg.types, name)
+		}
+	}
+
+	return cfg, nil
+}
+
+// replacement replaces src[start:end] with text.
+type replacement struct {
+	start, end int
+	text       string
+}
+
+// rewrite returns src with the struct tags updated according to cfg and
+// formatted with gofmt. Fields that cannot be tagged are reported to stderr.
+func rewrite(filename string, src []byte, cfg config, stderr io.Writer) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var replacements []replacement
+	var tagErr error
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok {
+			return true
+		}
+
+		if len(cfg.types) > 0 && !slices.Contains(cfg.types, spec.Name.Name) {
+			return false
+		}
+
+		// nested struct types are reached through the fields of spec.
+		ast.Inspect(spec.Type, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				r, ok, err := tagField(fset, field, cfg, stderr)
+				if err != nil && tagErr == nil {
+					tagErr = err
+				}
+
+				if ok {
+					replacements = append(replacements, r)
+				}
+			}
+
+			return true
+		})
+
+		return false
+	})
+
+	if tagErr != nil {
+		return nil, tagErr
+	}
+
+	if len(replacements) == 0 {
+		return src, nil
+	}
+
+	sort.Slice(replacements, func(i, j int) bool { return replacements[i].start < replacements[j].start })
+	var buf bytes.Buffer
+	last := 0
+	for _, r := range replacements {
+		buf.Write(src[last:r.start])
+		buf.WriteString(r.text)
+		last = r.end
+	}
+
+	buf.Write(src[last:])
+	return format.Source(buf.Bytes())
+}
+
+// tagField returns the replacement of the tag of field, if it changes.
+func tagField(fset *token.FileSet, field *ast.Field, cfg config, stderr io.Writer) (replacement, bool, error) {
+	if len(field.Names) > 1 {
+		warnShared(fset, field, stderr)
+		return replacement{}, false, nil
+	}
+
+	// embedded fields keep their tags, since their names come from the type.
+	if len(field.Names) != 1 || !field.Names[0].IsExported() {
+		return replacement{}, false, nil
+	}
+
+	name := field.Names[0].Name
+	var tags []tagPair
+	if field.Tag != nil {
+		value, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return replacement{}, false, err
+		}
+
+		if tags, err = parseTag(value); err != nil {
+			return replacement{}, false, fmt.Errorf("%s: field %s: %w", fset.Position(field.Tag.Pos()), name, err)
+		}
+	}
+
+	changed := false
+	for _, spec := range cfg.tags {
+		want := string(xrunes.Convert([]rune(name), spec.style, xrunes.SplitAcronyms))
+		i := slices.IndexFunc(tags, func(p tagPair) bool { return p.key == spec.key })
+		if i < 0 {
+			tags = append(tags, tagPair{key: spec.key, value: want})
+			changed = true
+			continue
+		}
+
+		current, options, _ := strings.Cut(tags[i].value, ",")
+		if !cfg.overwrite || current == "-" || current == want {
+			continue
+		}
+
+		if options != "" {
+			want += "," + options
+		}
+
+		tags[i].value = want
+		changed = true
+	}
+
+	if !changed {
+		return replacement{}, false, nil
+	}
+
+	text := "`" + formatTag(tags) + "`"
+	if strings.Contains(formatTag(tags), "`") {
+		text = strconv.Quote(formatTag(tags))
+	}
+
+	if field.Tag != nil {
+		return replacement{
+			start: fset.Position(field.Tag.Pos()).Offset,
+			end:   fset.Position(field.Tag.End()).Offset,
+			text:  text,
+		}, true, nil
+	}
+
+	end := fset.Position(field.Type.End()).Offset
+	return replacement{start: end, end: end, text: " " + text}, true, nil
+}
+
+// warnShared reports a declaration of several fields with at least one
+// exported name, whose tag cannot give each of them its own name.
+func warnShared(fset *token.FileSet, field *ast.Field, stderr io.Writer) {
+	names := make([]string, len(field.Names))
+	exported := false
+	for i, name := range field.Names {
+		names[i] = name.Name
+		exported = exported || name.IsExported()
+	}
+
+	if exported {
+		fmt.Fprintf(stderr, "xrunes-tags: %s: fields %s share a tag and are not tagged; declare them separately\n",
+			fset.Position(field.Pos()), strings.Join(names, ", "))
+	}
+}
+
+// tagPair is a key and its value in a struct tag.
+type tagPair struct {
+	key, value string
+}
+
+// parseTag splits a struct tag into its key:"value" pairs, following the
+// conventions of reflect.StructTag.
+func parseTag(tag string) ([]tagPair, error) {
+	var pairs []tagPair
+	for {
+		tag = strings.TrimLeft(tag, " ")
+		if tag == "" {
+			return pairs, nil
+		}
+
+		i := 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, fmt.Errorf("malformed struct tag %q", tag)
+		}
+
+		key := tag[:i]
+		tag = tag[i+1:]
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+
+			i++
+		}
+
+		if i >= len(tag) {
+			return nil, fmt.Errorf("malformed struct tag value for %q", key)
+		}
+
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return nil, fmt.Errorf("malformed struct tag value for %q: %w", key, err)
+		}
+
+		pairs = append(pairs, tagPair{key: key, value: value})
+		tag = tag[i+1:]
+	}
+}
+
+func formatTag(pairs []tagPair) string {
+	parts := make([]string, len(pairs))
+	for i, p := range pairs {
+		parts[i] = p.key + ":" + strconv.Quote(p.value)
+	}
+
+	return strings.Join(parts, " ")
+}
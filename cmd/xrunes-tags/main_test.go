@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const source = `package models
+
+type Base struct{}
+
+type User struct {
+	Base
+	ID        int
+	UserName  string ` + "`json:\"name,omitempty\"`" + `
+	HTTPProxy string
+	Secret    string ` + "`json:\"-\"`" + `
+	internal  bool
+	Address   struct {
+		StreetName string
+	}
+}
+
+type Other struct {
+	MaxConns int
+}
+`
+
+func mustConfig(t *testing.T, tags, style, types string) config {
+	t.Helper()
+	cfg, err := parseConfig(tags, style, types)
+	require.NoError(t, err)
+	return cfg
+}
+
+func TestRewrite(t *testing.T) {
+	out, err := rewrite("models.go", []byte(source), mustConfig(t, "json,env:screaming-snake", "snake", ""), io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, `package models
+
+type Base struct{}
+
+type User struct {
+	Base
+	ID        int    `+"`json:\"id\" env:\"ID\"`"+`
+	UserName  string `+"`json:\"name,omitempty\" env:\"USER_NAME\"`"+`
+	HTTPProxy string `+"`json:\"http_proxy\" env:\"HTTP_PROXY\"`"+`
+	Secret    string `+"`json:\"-\" env:\"SECRET\"`"+`
+	internal  bool
+	Address   struct {
+		StreetName string `+"`json:\"street_name\" env:\"STREET_NAME\"`"+`
+	} `+"`json:\"address\" env:\"ADDRESS\"`"+`
+}
+
+type Other struct {
+	MaxConns int `+"`json:\"max_conns\" env:\"MAX_CONNS\"`"+`
+}
+`, string(out))
+}
+
+func TestRewriteIdempotent(t *testing.T) {
+	for _, overwrite := range []bool{false, true} {
+		cfg := mustConfig(t, "json,yaml,db", "camel", "")
+		cfg.overwrite = overwrite
+		once, err := rewrite("models.go", []byte(source), cfg, io.Discard)
+		require.NoError(t, err)
+
+		twice, err := rewrite("models.go", once, cfg, io.Discard)
+		require.NoError(t, err)
+		assert.Equal(t, string(once), string(twice))
+	}
+}
+
+func TestRewriteOverwrite(t *testing.T) {
+	cfg := mustConfig(t, "json", "kebab", "User")
+	cfg.overwrite = true
+	out, err := rewrite("models.go", []byte(source), cfg, io.Discard)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "UserName  string `json:\"user-name,omitempty\"`")
+	assert.Contains(t, string(out), "Secret    string `json:\"-\"`")
+	assert.Contains(t, string(out), "\tMaxConns int\n")
+}
+
+func TestRewriteMalformedTag(t *testing.T) {
+	src := "package p\n\ntype T struct {\n\tName string `json:name`\n}\n"
+	_, err := rewrite("p.go", []byte(src), mustConfig(t, "json", "snake", ""), io.Discard)
+	assert.ErrorContains(t, err, "p.go:4:14: field Name: malformed struct tag")
+}
+
+func TestRewriteSharedFields(t *testing.T) {
+	src := "package p\n\ntype T struct {\n\tA, B string\n\tx, y int\n\tC    bool\n}\n"
+	var stderr bytes.Buffer
+	out, err := rewrite("p.go", []byte(src), mustConfig(t, "json", "snake", ""), &stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "package p\n\ntype T struct {\n\tA, B string\n\tx, y int\n\tC    bool `json:\"c\"`\n}\n", string(out))
+	assert.Equal(t, "xrunes-tags: p.go:4:2: fields A, B share a tag and are not tagged; declare them separately\n", stderr.String())
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg := mustConfig(t, "json, env:screaming-snake", "camel", "User,Order")
+	assert.Equal(t, []tagSpec{{"json", styles["camel"]}, {"env", styles["screaming-snake"]}}, cfg.tags)
+	assert.Equal(t, []string{"User", "Order"}, cfg.types)
+
+	_, err := parseConfig("json", "upper", "")
+	assert.Error(t, err)
+	_, err = parseConfig("json:upper", "snake", "")
+	assert.Error(t, err)
+	_, err = parseConfig("", "snake", "")
+	assert.Error(t, err)
+}
+
+func TestRunDryRun(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "other.go")
+	src := "package models\n\ntype Other struct {\n\tMaxConns int\n}\n"
+	require.NoError(t, os.WriteFile(file, []byte(src), 0o644))
+
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, run([]string{"-dry-run", file}, &stdout, &stderr))
+	assert.Equal(t, "--- "+file+"\n+++ "+file+"\n"+`@@ -1,5 +1,5 @@
+ package models
+ 
+ type Other struct {
+-	MaxConns int
++	MaxConns int `+"`json:\"max_conns\"`"+`
+ }
+`, stdout.String())
+
+	unchanged, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Equal(t, src, string(unchanged))
+
+	t.Setenv("GOFILE", file)
+	stdout.Reset()
+	require.NoError(t, run(nil, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+
+	written, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), "MaxConns int `json:\"max_conns\"`")
+}
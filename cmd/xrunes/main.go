@@ -0,0 +1,229 @@
+// Command xrunes exposes the xrunes library on standard input and output. It
+// processes its input line by line, so it can be used in shell pipelines and
+// as an editor filter; a last line without a newline is written back without
+// one.
+//
+// Usage:
+//
+//	xrunes snake|kebab [--screaming] [--preserve-case] [--split-acronyms]
+//	xrunes camel|pascal
+//	xrunes fold-grep [-n] [-v] PATTERN
+//	xrunes width
+//	xrunes normalize [--nfc|--nfd]
+//
+// The commands are:
+//
+//	snake      converts each line to snake_case with xrunes.Underscore
+//	kebab      converts each line to kebab-case with xrunes.Dasherize
+//	camel      converts each line to camelCase with xrunes.CamelCase
+//	pascal     converts each line to PascalCase with xrunes.PascalCase
+//	fold-grep  prints the lines that contain PATTERN, ignoring case as
+//	           xrunes.EqualFold does; it exits with status 1 when no line
+//	           matches
+//	width      prints the number of terminal columns of each line
+//	normalize  converts each line to Unicode NFC (the default) or NFD
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+	"github.com/jolt9dev/go-xrunes/internal/textwidth"
+	"golang.org/x/text/unicode/norm"
+)
+
+const usage = `usage:
+	xrunes snake|kebab [--screaming] [--preserve-case] [--split-acronyms]
+	xrunes camel|pascal
+	xrunes fold-grep [-n] [-v] PATTERN
+	xrunes width
+	xrunes normalize [--nfc|--nfd]
+`
+
+// errNoMatch is returned by fold-grep when no line matches.
+var errNoMatch = errors.New("no match")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command in args and returns the exit status: 0 on
+// success, 1 when fold-grep matches nothing and 2 on error.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	err := dispatch(args[0], args[1:], stdin, stdout, stderr)
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errNoMatch):
+		return 1
+	case errors.Is(err, flag.ErrHelp):
+		return 2
+	default:
+		fmt.Fprintln(stderr, "xrunes:", err)
+		return 2
+	}
+}
+
+func dispatch(cmd string, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("xrunes "+cmd, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	switch cmd {
+	case "snake", "kebab":
+		screaming := flags.Bool("screaming", false, "convert letters to uppercase")
+		preserveCase := flags.Bool("preserve-case", false, "keep the case of letters")
+		splitAcronyms := flags.Bool("split-acronyms", false, "end an acronym before a following word")
+		if err := parse(flags, args, 0); err != nil {
+			return err
+		}
+
+		options := make([]xrunes.HyphenMinusOption, 0, 3)
+		if *screaming {
+			options = append(options, xrunes.Screaming)
+		}
+
+		if *preserveCase {
+			options = append(options, xrunes.PreserveCase)
+		}
+
+		if *splitAcronyms {
+			options = append(options, xrunes.SplitAcronyms)
+		}
+
+		transform := xrunes.Underscore
+		if cmd == "kebab" {
+			transform = xrunes.Dasherize
+		}
+
+		return eachLine(stdin, stdout, func(line []rune) ([]rune, bool) {
+			return transform(line, options...), true
+		})
+	case "camel", "pascal":
+		if err := parse(flags, args, 0); err != nil {
+			return err
+		}
+
+		transform := xrunes.CamelCase
+		if cmd == "pascal" {
+			transform = xrunes.PascalCase
+		}
+
+		return eachLine(stdin, stdout, func(line []rune) ([]rune, bool) {
+			return transform(line), true
+		})
+	case "fold-grep":
+		lineNumbers := flags.Bool("n", false, "prefix each line with its line number")
+		invert := flags.Bool("v", false, "print the lines that do not match")
+		if err := parse(flags, args, 1); err != nil {
+			return err
+		}
+
+		pattern := []rune(flags.Arg(0))
+		n := 0
+		matched := false
+		err := eachLine(stdin, stdout, func(line []rune) ([]rune, bool) {
+			n++
+			if xrunes.ContainsFold(line, pattern) == *invert {
+				return nil, false
+			}
+
+			matched = true
+			if *lineNumbers {
+				return append([]rune(strconv.Itoa(n)+":"), line...), true
+			}
+
+			return line, true
+		})
+
+		if err == nil && !matched {
+			return errNoMatch
+		}
+
+		return err
+	case "width":
+		if err := parse(flags, args, 0); err != nil {
+			return err
+		}
+
+		return eachLine(stdin, stdout, func(line []rune) ([]rune, bool) {
+			return []rune(strconv.Itoa(textwidth.Runes(line))), true
+		})
+	case "normalize":
+		flags.Bool("nfc", false, "convert to Normalization Form C (default)")
+		nfd := flags.Bool("nfd", false, "convert to Normalization Form D")
+		if err := parse(flags, args, 0); err != nil {
+			return err
+		}
+
+		form := norm.NFC
+		if *nfd {
+			form = norm.NFD
+		}
+
+		return eachLine(stdin, stdout, func(line []rune) ([]rune, bool) {
+			return []rune(form.String(string(line))), true
+		})
+	default:
+		fmt.Fprint(stderr, usage)
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+}
+
+// parse parses the flags of a command that takes exactly n arguments.
+func parse(flags *flag.FlagSet, args []string, n int) error {
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != n {
+		return fmt.Errorf("%s: expected %d argument(s), got %d", flags.Name(), n, flags.NArg())
+	}
+
+	return nil
+}
+
+// eachLine calls fn with every line of r, without its line ending, and writes
+// the lines fn returns to w. Lines ending with "\r\n" keep that ending.
+func eachLine(r io.Reader, w io.Writer, fn func(line []rune) ([]rune, bool)) error {
+	br := bufio.NewReader(r)
+	bw := bufio.NewWriter(w)
+	for {
+		s, err := br.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if s != "" {
+			line := s
+			eol := ""
+			if strings.HasSuffix(line, "\n") {
+				line = line[:len(line)-1]
+				eol = "\n"
+				if strings.HasSuffix(line, "\r") {
+					line = line[:len(line)-1]
+					eol = "\r\n"
+				}
+			}
+
+			if out, ok := fn([]rune(line)); ok {
+				bw.WriteString(string(out))
+				bw.WriteString(eol)
+			}
+		}
+
+		if err == io.EOF {
+			return bw.Flush()
+		}
+	}
+}
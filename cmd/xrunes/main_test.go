@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func execute(args []string, input string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	status := run(args, strings.NewReader(input), &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestCaseCommands(t *testing.T) {
+	tests := []struct {
+		args     []string
+		input    string
+		expected string
+	}{
+		{[]string{"snake"}, "HTTPServer\nuser name\n", "httpserver\nuser_name\n"},
+		{[]string{"snake", "--split-acronyms"}, "HTTPServer\nuser name\n", "http_server\nuser_name\n"},
+		{[]string{"snake", "--screaming"}, "maxConns\n", "MAX_CONNS\n"},
+		{[]string{"snake", "--preserve-case"}, "Hello World\n", "Hello_World\n"},
+		{[]string{"kebab", "--split-acronyms"}, "XMLHttpRequest", "xml-http-request"},
+		{[]string{"kebab", "--screaming"}, "content type\r\n", "CONTENT-TYPE\r\n"},
+		{[]string{"camel"}, "user_name\n\nmax-conns\n", "userName\n\nmaxConns\n"},
+		{[]string{"pascal"}, "user_name\n", "UserName\n"},
+		{[]string{"snake"}, "", ""},
+	}
+
+	for _, tt := range tests {
+		status, stdout, stderr := execute(tt.args, tt.input)
+		assert.Equal(t, 0, status, "%v: %s", tt.args, stderr)
+		assert.Equal(t, tt.expected, stdout, "%v", tt.args)
+	}
+}
+
+func TestFoldGrep(t *testing.T) {
+	input := "Hello World\nnothing here\nHELLO again\n"
+	status, stdout, _ := execute([]string{"fold-grep", "hello"}, input)
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "Hello World\nHELLO again\n", stdout)
+
+	status, stdout, _ = execute([]string{"fold-grep", "-n", "-v", "hello"}, input)
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "2:nothing here\n", stdout)
+
+	status, stdout, _ = execute([]string{"fold-grep", "ΣΊΣΥΦΟΣ"}, "σίσυφος\n")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "σίσυφος\n", stdout)
+
+	status, stdout, _ = execute([]string{"fold-grep", "missing"}, input)
+	assert.Equal(t, 1, status)
+	assert.Empty(t, stdout)
+}
+
+func TestWidth(t *testing.T) {
+	status, stdout, _ := execute([]string{"width"}, "Go言語\ncafé\n\n")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "6\n4\n0\n", stdout)
+}
+
+func TestNormalize(t *testing.T) {
+	status, stdout, _ := execute([]string{"normalize", "--nfc"}, "cafe\u0301\n")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "caf\u00e9\n", stdout)
+
+	status, stdout, _ = execute([]string{"normalize"}, "cafe\u0301")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "caf\u00e9", stdout)
+
+	status, stdout, _ = execute([]string{"normalize", "--nfd"}, "caf\u00e9\n")
+	assert.Equal(t, 0, status)
+	assert.Equal(t, "cafe\u0301\n", stdout)
+}
+
+func TestErrors(t *testing.T) {
+	status, _, stderr := execute(nil, "")
+	assert.Equal(t, 2, status)
+	assert.Contains(t, stderr, "usage:")
+
+	status, _, stderr = execute([]string{"shout"}, "")
+	assert.Equal(t, 2, status)
+	assert.Contains(t, stderr, `unknown command "shout"`)
+
+	status, _, stderr = execute([]string{"fold-grep"}, "")
+	assert.Equal(t, 2, status)
+	assert.Contains(t, stderr, "expected 1 argument(s), got 0")
+
+	status, _, _ = execute([]string{"snake", "--loud"}, "")
+	assert.Equal(t, 2, status)
+}
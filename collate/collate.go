@@ -0,0 +1,437 @@
+// Package collate implements the Unicode Collation Algorithm (UTS #10) over
+// slices of runes, using tables generated from the Default Unicode Collation
+// Element Table, so that user facing lists sort in a linguistically sensible
+// order: "école" sorts next to "ecole" rather than after "z".
+package collate
+
+//go:generate go run gen.go -allkeys allkeys.txt
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Strength is the number of levels of the collation elements that are compared.
+type Strength int
+
+const (
+	// Primary compares base letters only, so "école", "Ecole" and "ecole" are equal.
+	Primary Strength = iota + 1
+	// Secondary also compares accents, so "école" differs from "ecole".
+	Secondary
+	// Tertiary also compares case and variants, so "Ecole" differs from "ecole".
+	Tertiary
+	// Quaternary also compares punctuation and symbols that are ignored by the
+	// lower levels when variable elements are shifted.
+	Quaternary
+)
+
+// Params defines the parameters used by a Collator.
+type Params struct {
+	// Strength is the number of levels compared. It defaults to Tertiary.
+	Strength Strength
+	// Shifted shifts variable collation elements, such as spaces, punctuation and
+	// most symbols, to the quaternary level so they are ignored by the lower
+	// levels. By default they are compared like letters (non-ignorable).
+	Shifted bool
+}
+
+// Option is a function type that modifies the options for Params.
+type Option func(params *Params)
+
+// WithStrength returns an Option that sets the Strength of the Collator.
+func WithStrength(strength Strength) Option {
+	return func(params *Params) {
+		params.Strength = strength
+	}
+}
+
+// Shifted sets the Shifted field of the given Params to true.
+func Shifted(params *Params) {
+	params.Shifted = true
+}
+
+// Collator compares slices of runes using the Unicode Collation Algorithm with
+// the Default Unicode Collation Element Table (DUCET).
+//
+// Input is converted to NFD before collation elements are looked up, so
+// canonically equivalent slices compare equal, and contractions are matched
+// across unblocked combining marks as described in section 7.1 of UTS #10. A
+// Collator is immutable and safe for concurrent use.
+type Collator struct {
+	params Params
+}
+
+// New creates a Collator.
+//
+// Example:
+//
+//	c := New(WithStrength(Primary))
+//	c.Compare([]rune("école"), []rune("Ecole")) // 0
+func New(options ...Option) *Collator {
+	c := &Collator{params: Params{Strength: Tertiary}}
+	for _, option := range options {
+		option(&c.params)
+	}
+
+	if c.params.Strength < Primary {
+		c.params.Strength = Primary
+	}
+
+	if c.params.Strength > Quaternary {
+		c.params.Strength = Quaternary
+	}
+
+	return c
+}
+
+var defaultCollator = New()
+
+// Compare compares a and b using a tertiary strength, non-ignorable Collator.
+// The result is 0 if a == b, -1 if a < b and +1 if a > b.
+//
+// Example:
+//
+//	c := Compare([]rune("école"), []rune("ecrire")) // c will be -1
+func Compare(a []rune, b []rune) int {
+	return defaultCollator.Compare(a, b)
+}
+
+// Key returns the sort key of s using a tertiary strength, non-ignorable Collator.
+func Key(s []rune) []byte {
+	return defaultCollator.Key(s)
+}
+
+// Compare compares a and b. The result is 0 if a == b, -1 if a < b and +1 if a > b.
+func (c *Collator) Compare(a []rune, b []rune) int {
+	return bytes.Compare(c.Key(a), c.Key(b))
+}
+
+// Key returns the sort key of s. Comparing two sort keys with bytes.Compare
+// gives the same result as comparing the slices they were computed from with
+// Compare, so keys can be stored and compared many times cheaply.
+func (c *Collator) Key(s []rune) []byte {
+	ces := appendElements(nil, s)
+	key := make([]byte, 0, len(ces)*int(c.params.Strength)*2)
+
+	if c.params.Shifted {
+		shifted := false
+		for i, ce := range ces {
+			switch {
+			case ce.variable:
+				ces[i] = element{quaternary: ce.primary}
+				shifted = true
+			case ce.primary == 0 && ce.secondary == 0 && ce.tertiary == 0:
+			case ce.primary == 0 && shifted:
+				ces[i] = element{}
+			default:
+				ces[i].quaternary = 0xFFFF
+				shifted = false
+			}
+		}
+	}
+
+	for level := Primary; level <= c.params.Strength; level++ {
+		if level > Primary {
+			key = append(key, 0, 0)
+		}
+
+		for _, ce := range ces {
+			var w uint16
+			switch level {
+			case Primary:
+				w = ce.primary
+			case Secondary:
+				w = ce.secondary
+			case Tertiary:
+				w = ce.tertiary
+			default:
+				if !c.params.Shifted {
+					if ce.primary|ce.secondary|ce.tertiary != 0 {
+						w = 0xFFFF
+					}
+				} else {
+					w = ce.quaternary
+				}
+			}
+
+			if w != 0 {
+				key = append(key, byte(w>>8), byte(w))
+			}
+		}
+	}
+
+	return key
+}
+
+// Sort sorts s in place using the Collator. Sort keys are computed once per slice.
+func (c *Collator) Sort(s [][]rune) {
+	keys := make([][]byte, len(s))
+	for i, v := range s {
+		keys[i] = c.Key(v)
+	}
+
+	sort.Stable(&keyedSlice{s: s, keys: keys})
+}
+
+type keyedSlice struct {
+	s    [][]rune
+	keys [][]byte
+}
+
+func (k *keyedSlice) Len() int {
+	return len(k.s)
+}
+
+func (k *keyedSlice) Less(i, j int) bool {
+	return bytes.Compare(k.keys[i], k.keys[j]) < 0
+}
+
+func (k *keyedSlice) Swap(i, j int) {
+	k.s[i], k.s[j] = k.s[j], k.s[i]
+	k.keys[i], k.keys[j] = k.keys[j], k.keys[i]
+}
+
+type element struct {
+	primary    uint16
+	secondary  uint16
+	tertiary   uint16
+	quaternary uint16
+	variable   bool
+}
+
+type implicitRange struct {
+	lo   rune
+	hi   rune
+	base uint16
+}
+
+type contraction struct {
+	s     string
+	elems uint32
+}
+
+// contractionIndex maps the first rune of each contraction to its index in
+// contractions. Contractions sharing a first rune are contiguous and sorted
+// longest first.
+var contractionIndex = func() map[rune]int {
+	m := make(map[rune]int)
+	for i := len(contractions) - 1; i >= 0; i-- {
+		r, _ := utf8.DecodeRuneInString(contractions[i].s)
+		m[r] = i
+	}
+
+	return m
+}()
+
+// contractionElems maps every contraction to its packed collation elements,
+// to extend a match with discontiguous combining marks.
+var contractionElems = func() map[string]uint32 {
+	m := make(map[string]uint32, len(contractions))
+	for _, c := range contractions {
+		m[c.s] = c.elems
+	}
+
+	return m
+}()
+
+// appendElements appends the collation elements of s to ces, following the
+// steps S1 and S2 of UTS #10.
+func appendElements(ces []element, s []rune) []element {
+	s = nfd(s)
+	for i := 0; i < len(s); {
+		start, ok := contractionIndex[s[i]]
+		if !ok {
+			ces = appendRune(ces, s[i])
+			i++
+			continue
+		}
+
+		// S2.1: the longest contiguous match.
+		elems, n := matchContraction(s[i:], start)
+		matched := n > 0
+		if !matched {
+			n = 1
+		}
+
+		// S2.1.1 to S2.1.3: extend the match with the following non-starters
+		// that are not blocked from it, removing each one that is used.
+		match := string(s[i : i+n])
+		last := uint8(0)
+		for j := i + n; j < len(s); {
+			class := combiningClass(s[j])
+			if class == 0 {
+				break
+			}
+
+			if last < class {
+				if e, ok := contractionElems[match+string(s[j])]; ok {
+					match += string(s[j])
+					elems = e
+					matched = true
+					s = slices.Delete(s, j, j+1)
+					continue
+				}
+			}
+
+			last = class
+			j++
+		}
+
+		if matched {
+			ces = appendPacked(ces, elems)
+		} else {
+			ces = appendRune(ces, s[i])
+		}
+
+		i += n
+	}
+
+	return ces
+}
+
+func matchContraction(s []rune, start int) (uint32, int) {
+	first := s[0]
+	for _, c := range contractions[start:] {
+		r, size := utf8.DecodeRuneInString(c.s)
+		if r != first {
+			break
+		}
+
+		n := 1
+		rest := c.s[size:]
+		for len(rest) > 0 && n < len(s) {
+			r, size = utf8.DecodeRuneInString(rest)
+			if r != s[n] {
+				break
+			}
+
+			rest = rest[size:]
+			n++
+		}
+
+		if len(rest) == 0 {
+			return c.elems, n
+		}
+	}
+
+	return 0, 0
+}
+
+// nfd returns s in Normalization Form D. Runes that are not valid Unicode
+// scalar values, such as surrogates, are kept as they are and collate with
+// implicit weights, since they would not survive a conversion to UTF-8.
+func nfd(s []rune) []rune {
+	out := make([]rune, 0, len(s))
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && utf8.ValidRune(s[i]) {
+			continue
+		}
+
+		// invalid runes have a combining class of 0, so normalizing the runs
+		// between them separately gives the same result.
+		out = append(out, []rune(norm.NFD.String(string(s[start:i])))...)
+		if i < len(s) {
+			out = append(out, s[i])
+		}
+
+		start = i + 1
+	}
+
+	return out
+}
+
+// combiningClass returns the canonical combining class of r.
+func combiningClass(r rune) uint8 {
+	if r < 0x300 || !utf8.ValidRune(r) {
+		return 0
+	}
+
+	var buf [utf8.UTFMax]byte
+	n := utf8.EncodeRune(buf[:], r)
+	return norm.NFD.Properties(buf[:n]).CCC()
+}
+
+func appendRune(ces []element, r rune) []element {
+	if i, ok := slices.BinarySearch(singleRunes[:], r); ok {
+		return appendPacked(ces, singleElems[i])
+	}
+
+	aaaa, bbbb := implicitWeight(r)
+	return append(ces,
+		element{primary: aaaa, secondary: 0x20, tertiary: 0x2},
+		element{primary: bbbb},
+	)
+}
+
+func appendPacked(ces []element, elems uint32) []element {
+	off := elems >> 8
+	n := elems & 0xFF
+	for _, ce := range elements[off : off+n] {
+		ces = append(ces, element{
+			primary:   uint16(ce >> 16),
+			secondary: uint16(ce>>6) & 0x1FF,
+			tertiary:  uint16(ce>>1) & 0x1F,
+			variable:  ce&1 == 1,
+		})
+	}
+
+	return ces
+}
+
+// implicitWeight returns the two primary weights derived for a rune that has
+// no entry in the table, as described in section 10.1 of UTS #10.
+func implicitWeight(r rune) (uint16, uint16) {
+	for _, im := range implicitWeights {
+		if r >= im.lo && r <= im.hi {
+			return im.base, uint16(r-im.lo) | 0x8000
+		}
+	}
+
+	base := rune(0xFBC0)
+	switch {
+	case isCoreHan(r):
+		base = 0xFB40
+	case isUnifiedIdeograph(r):
+		base = 0xFB80
+	}
+
+	return uint16(base + r>>15), uint16(r&0x7FFF) | 0x8000
+}
+
+// isCoreHan reports whether r is a unified ideograph in the CJK Unified
+// Ideographs or CJK Compatibility Ideographs blocks.
+func isCoreHan(r rune) bool {
+	if r >= 0x4E00 && r <= 0x9FFC {
+		return true
+	}
+
+	switch r {
+	case 0xFA0E, 0xFA0F, 0xFA11, 0xFA13, 0xFA14, 0xFA1F, 0xFA21, 0xFA23, 0xFA24, 0xFA27, 0xFA28, 0xFA29:
+		return true
+	}
+
+	return false
+}
+
+// isUnifiedIdeograph reports whether r is a unified ideograph outside of the
+// core Han blocks.
+func isUnifiedIdeograph(r rune) bool {
+	switch {
+	case r >= 0x3400 && r <= 0x4DBF,
+		r >= 0x20000 && r <= 0x2A6DD,
+		r >= 0x2A700 && r <= 0x2B734,
+		r >= 0x2B740 && r <= 0x2B81D,
+		r >= 0x2B820 && r <= 0x2CEA1,
+		r >= 0x2CEB0 && r <= 0x2EBE0,
+		r >= 0x30000 && r <= 0x3134A:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,168 @@
+package collate_test
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jolt9dev/go-xrunes/collate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The conformance tests read the official CollationTest files for the UCA
+// version the tables were generated from, which belong in testdata. A missing
+// file fails the tests; `just collation-testdata` fetches them:
+//
+//	curl -O https://www.unicode.org/Public/UCA/13.0.0/CollationTest.zip
+//	unzip -j CollationTest.zip '*_SHORT.txt' -d testdata
+const (
+	nonIgnorableTest = "testdata/CollationTest_NON_IGNORABLE_SHORT.txt"
+	shiftedTest      = "testdata/CollationTest_SHIFTED_SHORT.txt"
+)
+
+// readCollationTest reads a file in the CollationTest format, where each
+// line holds the code points of a string followed by an optional ';' and
+// comment, and lines are in collation order.
+func readCollationTest(t *testing.T, name string) [][]rune {
+	f, err := os.Open(name)
+	require.NoError(t, err, "the official CollationTest files must be in testdata")
+	defer f.Close()
+
+	lines := make([][]rune, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if v, ok := strings.CutPrefix(line, "# UCA Version: "); ok {
+			require.Equal(t, collate.UnicodeVersion, strings.TrimSpace(v), name)
+		}
+
+		if i := strings.IndexAny(line, ";#"); i >= 0 {
+			line = line[:i]
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		s := make([]rune, 0, len(fields))
+		for _, cp := range fields {
+			v, err := strconv.ParseUint(cp, 16, 32)
+			require.NoError(t, err)
+			s = append(s, rune(v))
+		}
+
+		lines = append(lines, s)
+	}
+
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+// checkOrder checks that every line of the test file sorts strictly before
+// the next one that differs from it, breaking ties by code point order as
+// the CollationTest files do.
+func checkOrder(t *testing.T, c *collate.Collator, name string) {
+	lines := readCollationTest(t, name)
+	require.NotEmpty(t, lines)
+
+	failures := 0
+	for i := 1; i < len(lines); i++ {
+		a, b := lines[i-1], lines[i]
+		if slices.Equal(a, b) {
+			continue
+		}
+
+		cmp := c.Compare(a, b)
+		if cmp == 0 {
+			cmp = slices.Compare(a, b)
+		}
+
+		if cmp >= 0 {
+			failures++
+			if failures <= 10 {
+				t.Errorf("line %d: %U does not sort before %U", i, a, b)
+			}
+		}
+	}
+}
+
+func TestCollationNonIgnorable(t *testing.T) {
+	checkOrder(t, collate.New(), nonIgnorableTest)
+}
+
+func TestCollationShifted(t *testing.T) {
+	checkOrder(t, collate.New(collate.Shifted, collate.WithStrength(collate.Quaternary)), shiftedTest)
+}
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, collate.Compare([]rune("école"), []rune("ecrire")))
+	assert.Equal(t, -1, collate.Compare([]rune("école"), []rune("zebra")))
+	assert.Equal(t, -1, collate.Compare([]rune("ecole"), []rune("école")))
+	assert.Equal(t, -1, collate.Compare([]rune("ecole"), []rune("Ecole")))
+	assert.Equal(t, 0, collate.Compare([]rune("ecole"), []rune("ecole")))
+}
+
+func TestNormalization(t *testing.T) {
+	assert.Equal(t, 0, collate.Compare([]rune("\u00e9"), []rune("e\u0301")))
+	assert.Equal(t, 0, collate.Compare([]rune("a\u0301\u0323"), []rune("a\u0323\u0301")))
+	assert.Equal(t, 0, collate.Compare([]rune("\uac00"), []rune("\u1100\u1161")))
+	assert.Equal(t, -1, collate.Compare([]rune{0xD800}, []rune{0xFFFD}))
+}
+
+func TestDiscontiguousContraction(t *testing.T) {
+	// "\u0438\u0306" contracts to a letter with its own primary weight, even
+	// when a combining mark of a lower class comes between them.
+	primary := collate.New(collate.WithStrength(collate.Primary))
+	assert.Equal(t, 0, primary.Compare([]rune("\u0438\u0306"), []rune("\u0439")))
+	assert.Equal(t, 0, primary.Compare([]rune("\u0438\u0323\u0306"), []rune("\u0439")))
+	assert.NotEqual(t, 0, primary.Compare([]rune("\u0438\u0323\u0306"), []rune("\u0438")))
+
+	// a mark of the same class blocks the second one.
+	assert.NotEqual(t, 0, primary.Compare([]rune("\u0438\u0301\u0306"), []rune("\u0439")))
+}
+
+func TestStrength(t *testing.T) {
+	primary := collate.New(collate.WithStrength(collate.Primary))
+	assert.Equal(t, 0, primary.Compare([]rune("école"), []rune("Ecole")))
+
+	secondary := collate.New(collate.WithStrength(collate.Secondary))
+	assert.Equal(t, 0, secondary.Compare([]rune("ecole"), []rune("Ecole")))
+	assert.Equal(t, -1, secondary.Compare([]rune("ecole"), []rune("école")))
+
+	tertiary := collate.New(collate.WithStrength(collate.Tertiary))
+	assert.Equal(t, -1, tertiary.Compare([]rune("ecole"), []rune("Ecole")))
+}
+
+func TestShifted(t *testing.T) {
+	c := collate.New(collate.Shifted, collate.WithStrength(collate.Tertiary))
+	assert.Equal(t, 0, c.Compare([]rune("co-op"), []rune("coop")))
+	assert.Equal(t, -1, collate.Compare([]rune("co-op"), []rune("coop")))
+
+	q := collate.New(collate.Shifted, collate.WithStrength(collate.Quaternary))
+	assert.Equal(t, -1, q.Compare([]rune("co-op"), []rune("coop")))
+}
+
+func TestKey(t *testing.T) {
+	a := collate.Key([]rune("école"))
+	b := collate.Key([]rune("ecrire"))
+	assert.Equal(t, -1, bytes.Compare(a, b))
+	assert.Empty(t, collate.New(collate.WithStrength(collate.Primary)).Key(nil))
+}
+
+func TestSort(t *testing.T) {
+	s := [][]rune{[]rune("zebra"), []rune("école"), []rune("Ecole"), []rune("ecole"), []rune("apple")}
+	collate.New().Sort(s)
+
+	names := make([]string, len(s))
+	for i, v := range s {
+		names[i] = string(v)
+	}
+
+	assert.Equal(t, []string{"apple", "ecole", "Ecole", "école", "zebra"}, names)
+}
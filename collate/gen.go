@@ -0,0 +1,222 @@
+//go:build ignore
+
+// gen generates tables.go from the Default Unicode Collation Element Table.
+//
+// Usage:
+//
+//	curl -O https://www.unicode.org/Public/UCA/13.0.0/allkeys.txt
+//	go run gen.go -allkeys allkeys.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type entry struct {
+	runes []rune
+	ces   []uint32
+}
+
+type implicit struct {
+	lo, hi rune
+	base   uint32
+}
+
+func main() {
+	allkeys := flag.String("allkeys", "allkeys.txt", "path to the DUCET allkeys.txt file")
+	output := flag.String("output", "tables.go", "path of the generated file")
+	flag.Parse()
+
+	f, err := os.Open(*allkeys)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	version := ""
+	implicits := make([]implicit, 0)
+	singles := make([]entry, 0)
+	contractions := make([]entry, 0)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if v, ok := strings.CutPrefix(line, "@version "); ok {
+			version = strings.TrimSpace(v)
+			continue
+		}
+
+		if v, ok := strings.CutPrefix(line, "@implicitweights "); ok {
+			rng, base, _ := strings.Cut(v, ";")
+			lo, hi, _ := strings.Cut(strings.TrimSpace(rng), "..")
+			implicits = append(implicits, implicit{
+				lo:   rune(parseHex(lo)),
+				hi:   rune(parseHex(hi)),
+				base: uint32(parseHex(strings.TrimSpace(base))),
+			})
+			continue
+		}
+
+		if strings.HasPrefix(line, "@") {
+			continue
+		}
+
+		cps, elements, ok := strings.Cut(line, ";")
+		if !ok {
+			log.Fatalf("malformed line %q", line)
+		}
+
+		e := entry{}
+		for _, cp := range strings.Fields(cps) {
+			e.runes = append(e.runes, rune(parseHex(cp)))
+		}
+
+		for _, ce := range strings.Split(strings.TrimSpace(elements), "]") {
+			ce = strings.TrimSpace(ce)
+			if ce == "" {
+				continue
+			}
+
+			// [.PPPP.SSSS.TTTT] or [*PPPP.SSSS.TTTT] for variable elements.
+			variable := ce[1] == '*'
+			weights := strings.Split(ce[2:], ".")
+			p := parseHex(weights[0])
+			s := parseHex(weights[1])
+			t := parseHex(weights[2])
+			if p > 0xFFFF || s > 0x1FF || t > 0x1F {
+				log.Fatalf("weights out of range in %q", line)
+			}
+
+			v := uint32(0)
+			if variable {
+				v = 1
+			}
+
+			e.ces = append(e.ces, uint32(p)<<16|uint32(s)<<6|uint32(t)<<1|v)
+		}
+
+		if len(e.runes) == 1 {
+			singles = append(singles, e)
+		} else {
+			contractions = append(contractions, e)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	slices.SortFunc(singles, func(a, b entry) int { return int(a.runes[0] - b.runes[0]) })
+	slices.SortStableFunc(contractions, func(a, b entry) int {
+		if a.runes[0] != b.runes[0] {
+			return int(a.runes[0] - b.runes[0])
+		}
+
+		// longest first so the first match is the longest one.
+		return len(b.runes) - len(a.runes)
+	})
+
+	ces := make([]uint32, 0)
+	index := make(map[string]uint32)
+	offset := func(e entry) uint32 {
+		key := fmt.Sprint(e.ces)
+		if off, ok := index[key]; ok {
+			return off
+		}
+
+		off := uint32(len(ces))<<8 | uint32(len(e.ces))
+		if len(e.ces) > 0xFF {
+			log.Fatalf("too many collation elements for %U", e.runes)
+		}
+
+		ces = append(ces, e.ces...)
+		index[key] = off
+		return off
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from allkeys.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package collate\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the version of the DUCET the tables were generated from.\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", version)
+
+	fmt.Fprintf(&buf, "var implicitWeights = []implicitRange{\n")
+	for _, im := range implicits {
+		fmt.Fprintf(&buf, "\t{0x%X, 0x%X, 0x%X},\n", im.lo, im.hi, im.base)
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	singleRunes := make([]string, len(singles))
+	singleElems := make([]string, len(singles))
+	for i, e := range singles {
+		singleRunes[i] = fmt.Sprintf("0x%X", e.runes[0])
+		singleElems[i] = fmt.Sprintf("0x%X", offset(e))
+	}
+
+	writeList(&buf, "singleRunes", "rune", singleRunes)
+	writeList(&buf, "singleElems", "uint32", singleElems)
+
+	fmt.Fprintf(&buf, "var contractions = []contraction{\n")
+	for _, e := range contractions {
+		fmt.Fprintf(&buf, "\t{%s, 0x%X},\n", strconv.QuoteToASCII(string(e.runes)), offset(e))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	elems := make([]string, len(ces))
+	for i, ce := range ces {
+		elems[i] = fmt.Sprintf("0x%X", ce)
+	}
+
+	writeList(&buf, "elements", "uint32", elems)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeList(buf *bytes.Buffer, name, typ string, values []string) {
+	fmt.Fprintf(buf, "var %s = [...]%s{", name, typ)
+	for i, v := range values {
+		if i%8 == 0 {
+			buf.WriteString("\n\t")
+		} else {
+			buf.WriteByte(' ')
+		}
+
+		buf.WriteString(v)
+		buf.WriteByte(',')
+	}
+	fmt.Fprintf(buf, "\n}\n\n")
+}
+
+func parseHex(s string) uint64 {
+	v, err := strconv.ParseUint(strings.TrimSpace(s), 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return v
+}
@@ -0,0 +1,270 @@
+package xrunes
+
+// LongestCommonPrefix returns the longest prefix shared by every slice in s.
+// The result is a sub-slice of the first slice. It returns nil when s is empty.
+//
+// Example:
+//
+//	p := LongestCommonPrefix([]rune("user_id"), []rune("user_name")) // p will be "user_"
+func LongestCommonPrefix(s ...[]rune) []rune {
+	return longestCommonPrefix(s, equalRune)
+}
+
+// LongestCommonPrefixFold returns the longest prefix shared by every slice in s,
+// using Unicode case-folding to compare runes. The result is a sub-slice of
+// the first slice, so it keeps that slice's spelling.
+func LongestCommonPrefixFold(s ...[]rune) []rune {
+	return longestCommonPrefix(s, equalRuneFold)
+}
+
+// LongestCommonSuffix returns the longest suffix shared by every slice in s.
+// The result is a sub-slice of the first slice. It returns nil when s is empty.
+//
+// Example:
+//
+//	p := LongestCommonSuffix([]rune("CreatedAt"), []rune("UpdatedAt")) // p will be "atedAt"
+func LongestCommonSuffix(s ...[]rune) []rune {
+	return longestCommonSuffix(s, equalRune)
+}
+
+// LongestCommonSuffixFold returns the longest suffix shared by every slice in s,
+// using Unicode case-folding to compare runes. The result is a sub-slice of
+// the first slice.
+func LongestCommonSuffixFold(s ...[]rune) []rune {
+	return longestCommonSuffix(s, equalRuneFold)
+}
+
+// LongestCommonSubstring returns the longest contiguous run of runes present in
+// both a and b, as a sub-slice of a, along with its index in a and in b. When
+// several runs have the same length, the one ending first in b is returned.
+// It runs in O(len(a)+len(b)) time using a suffix automaton built over a.
+//
+// Example:
+//
+//	s, i, j := LongestCommonSubstring([]rune("getUserName"), []rune("setUserNames"))
+//	// s will be "etUserName", i will be 1 and j will be 1
+func LongestCommonSubstring(a []rune, b []rune) (s []rune, aIndex int, bIndex int) {
+	return longestCommonSubstring(a, b, identityRune)
+}
+
+// LongestCommonSubstringFold is like LongestCommonSubstring but uses Unicode
+// case-folding to compare runes.
+func LongestCommonSubstringFold(a []rune, b []rune) (s []rune, aIndex int, bIndex int) {
+	return longestCommonSubstring(a, b, foldRune)
+}
+
+// LCSLength returns the length of the longest common subsequence of a and b.
+func LCSLength(a []rune, b []rune) int {
+	n := 0
+	myers(a, b, func(op EditOp, count int) {
+		if op == EditEqual {
+			n += count
+		}
+	})
+
+	return n
+}
+
+// LCS returns a longest common subsequence of a and b.
+//
+// Example:
+//
+//	s := LCS([]rune("AGGTAB"), []rune("GXTXAYB")) // s will be "GTAB"
+func LCS(a []rune, b []rune) []rune {
+	sb := make([]rune, 0)
+	for _, pair := range LCSAlignment(a, b) {
+		sb = append(sb, a[pair[0]])
+	}
+
+	return sb
+}
+
+// LCSAlignment returns the pairs of indexes [i, j] such that a[i] == b[j]
+// form a longest common subsequence of a and b, in increasing order.
+func LCSAlignment(a []rune, b []rune) [][2]int {
+	pairs := make([][2]int, 0)
+	i := 0
+	j := 0
+	myers(a, b, func(op EditOp, count int) {
+		switch op {
+		case EditInsert:
+			j += count
+		case EditDelete:
+			i += count
+		default:
+			for range count {
+				pairs = append(pairs, [2]int{i, j})
+				i++
+				j++
+			}
+		}
+	})
+
+	return pairs
+}
+
+func longestCommonPrefix(s [][]rune, eq func(x, y rune) bool) []rune {
+	if len(s) == 0 {
+		return nil
+	}
+
+	prefix := s[0]
+	for _, other := range s[1:] {
+		n := 0
+		for n < len(prefix) && n < len(other) && eq(prefix[n], other[n]) {
+			n++
+		}
+
+		prefix = prefix[:n]
+	}
+
+	return prefix
+}
+
+func longestCommonSuffix(s [][]rune, eq func(x, y rune) bool) []rune {
+	if len(s) == 0 {
+		return nil
+	}
+
+	suffix := s[0]
+	for _, other := range s[1:] {
+		n := 0
+		for n < len(suffix) && n < len(other) && eq(suffix[len(suffix)-1-n], other[len(other)-1-n]) {
+			n++
+		}
+
+		suffix = suffix[len(suffix)-n:]
+	}
+
+	return suffix
+}
+
+// samState is a state of a suffix automaton.
+type samState struct {
+	length int
+	link   int
+	next   map[rune]int
+}
+
+// buildSuffixAutomaton returns the suffix automaton of s, with every rune
+// mapped through key first.
+func buildSuffixAutomaton(s []rune, key func(r rune) rune) []samState {
+	states := make([]samState, 1, 2*len(s)+1)
+	states[0] = samState{link: -1, next: make(map[rune]int)}
+	last := 0
+	for _, r := range s {
+		c := key(r)
+		cur := len(states)
+		states = append(states, samState{length: states[last].length + 1, next: make(map[rune]int)})
+		p := last
+		for p != -1 {
+			if _, ok := states[p].next[c]; ok {
+				break
+			}
+
+			states[p].next[c] = cur
+			p = states[p].link
+		}
+
+		switch {
+		case p == -1:
+			states[cur].link = 0
+		case states[p].length+1 == states[states[p].next[c]].length:
+			states[cur].link = states[p].next[c]
+		default:
+			q := states[p].next[c]
+			clone := len(states)
+			next := make(map[rune]int, len(states[q].next))
+			for k, v := range states[q].next {
+				next[k] = v
+			}
+
+			states = append(states, samState{length: states[p].length + 1, link: states[q].link, next: next})
+			for p != -1 && states[p].next[c] == q {
+				states[p].next[c] = clone
+				p = states[p].link
+			}
+
+			states[q].link = clone
+			states[cur].link = clone
+		}
+
+		last = cur
+	}
+
+	return states
+}
+
+func longestCommonSubstring(a, b []rune, key func(r rune) rune) ([]rune, int, int) {
+	if len(a) == 0 || len(b) == 0 {
+		return []rune{}, 0, 0
+	}
+
+	states := buildSuffixAutomaton(a, key)
+
+	// firstEnd[v] is the end position in a of the first occurrence of the
+	// substrings represented by state v.
+	firstEnd := make([]int, len(states))
+	for i := range firstEnd {
+		firstEnd[i] = -1
+	}
+
+	v := 0
+	for i, r := range a {
+		v = states[v].next[key(r)]
+		firstEnd[v] = i
+	}
+
+	// clones inherit the first occurrence of the state they were cloned from,
+	// which is the earliest end position among the states linking to them.
+	byLength := make([][]int, len(a)+1)
+	for i, st := range states {
+		byLength[st.length] = append(byLength[st.length], i)
+	}
+
+	for l := len(a); l > 0; l-- {
+		for _, i := range byLength[l] {
+			link := states[i].link
+			if link > 0 && firstEnd[i] >= 0 && (firstEnd[link] < 0 || firstEnd[i] < firstEnd[link]) {
+				firstEnd[link] = firstEnd[i]
+			}
+		}
+	}
+
+	best := 0
+	bestState := 0
+	bestEnd := 0
+	v = 0
+	l := 0
+	for j, r := range b {
+		c := key(r)
+		for v != 0 {
+			if _, ok := states[v].next[c]; ok {
+				break
+			}
+
+			v = states[v].link
+			l = states[v].length
+		}
+
+		if next, ok := states[v].next[c]; ok {
+			v = next
+			l++
+		}
+
+		if l > best {
+			best = l
+			bestState = v
+			bestEnd = j
+		}
+	}
+
+	if best == 0 {
+		return []rune{}, 0, 0
+	}
+
+	aEnd := firstEnd[bestState]
+	aStart := aEnd - best + 1
+
+	return a[aStart : aEnd+1], aStart, bestEnd - best + 1
+}
@@ -0,0 +1,85 @@
+package xrunes_test
+
+import (
+	"math/rand"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLongestCommonPrefix(t *testing.T) {
+	assert.Equal(t, []rune("user_"), runes.LongestCommonPrefix([]rune("user_id"), []rune("user_name"), []rune("user_")))
+	assert.Equal(t, []rune(""), runes.LongestCommonPrefix([]rune("abc"), []rune("xyz")))
+	assert.Equal(t, []rune("abc"), runes.LongestCommonPrefix([]rune("abc")))
+	assert.Nil(t, runes.LongestCommonPrefix())
+	assert.Equal(t, []rune(""), runes.LongestCommonPrefix([]rune("User"), []rune("user")))
+	assert.Equal(t, []rune("UserN"), runes.LongestCommonPrefixFold([]rune("UserName"), []rune("usernumber"), []rune("USERNAMES")))
+}
+
+func TestLongestCommonSuffix(t *testing.T) {
+	assert.Equal(t, []rune("atedAt"), runes.LongestCommonSuffix([]rune("CreatedAt"), []rune("UpdatedAt")))
+	assert.Equal(t, []rune("edAt"), runes.LongestCommonSuffix([]rune("CreatedAt"), []rune("UpdatedAt"), []rune("DeletedAt")[5:]))
+	assert.Equal(t, []rune(""), runes.LongestCommonSuffix([]rune("abc"), []rune("")))
+	assert.Nil(t, runes.LongestCommonSuffix())
+	assert.Equal(t, []rune("_ID"), runes.LongestCommonSuffixFold([]rune("USER_ID"), []rune("group_id")))
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	s, i, j := runes.LongestCommonSubstring([]rune("getUserName"), []rune("setUserNames"))
+	assert.Equal(t, "etUserName", string(s))
+	assert.Equal(t, 1, i)
+	assert.Equal(t, 1, j)
+
+	s, i, j = runes.LongestCommonSubstring([]rune("xxabcxxabcd"), []rune("zzabcd"))
+	assert.Equal(t, "abcd", string(s))
+	assert.Equal(t, 7, i)
+	assert.Equal(t, 2, j)
+
+	s, _, _ = runes.LongestCommonSubstring([]rune("abc"), []rune("xyz"))
+	assert.Empty(t, s)
+
+	s, i, j = runes.LongestCommonSubstringFold([]rune("MyHTTPServer"), []rune("http_server"))
+	assert.Equal(t, "Server", string(s))
+	assert.Equal(t, 6, i)
+	assert.Equal(t, 5, j)
+}
+
+func TestLongestCommonSubstringBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	gen := func() []rune {
+		r := make([]rune, rng.Intn(20))
+		for i := range r {
+			r[i] = rune('a' + rng.Intn(3))
+		}
+		return r
+	}
+
+	for n := 0; n < 500; n++ {
+		a := gen()
+		b := gen()
+		best := 0
+		for i := range a {
+			for j := range b {
+				l := 0
+				for i+l < len(a) && j+l < len(b) && a[i+l] == b[j+l] {
+					l++
+				}
+				best = max(best, l)
+			}
+		}
+
+		s, i, j := runes.LongestCommonSubstring(a, b)
+		assert.Equal(t, best, len(s))
+		if best > 0 {
+			assert.Equal(t, string(a[i:i+best]), string(b[j:j+best]))
+		}
+	}
+}
+
+func TestLCS(t *testing.T) {
+	assert.Equal(t, "GTAB", string(runes.LCS([]rune("AGGTAB"), []rune("GXTXAYB"))))
+	assert.Equal(t, 4, runes.LCSLength([]rune("AGGTAB"), []rune("GXTXAYB")))
+	assert.Equal(t, 0, runes.LCSLength([]rune("abc"), []rune("")))
+	assert.Equal(t, [][2]int{{0, 0}, {2, 1}}, runes.LCSAlignment([]rune("abc"), []rune("acx")))
+}
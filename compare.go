@@ -0,0 +1,73 @@
+package xrunes
+
+import (
+	"slices"
+	"sort"
+)
+
+// Compare returns an integer comparing two slices of runes lexicographically,
+// rune by rune. The result is 0 if a == b, -1 if a < b and +1 if a > b.
+func Compare(a []rune, b []rune) int {
+	return slices.Compare(a, b)
+}
+
+// CompareFold returns an integer comparing two slices of runes lexicographically
+// under Unicode case-folding. Each rune is replaced by the smallest letter of
+// its simple case-folding orbit before comparing, which makes CompareFold a
+// total order consistent with EqualFold: CompareFold(a, b) == 0 if and only if
+// EqualFold(a, b).
+//
+// Example:
+//
+//	c := CompareFold([]rune("Go"), []rune("GOPHER")) // c will be -1
+func CompareFold(a []rune, b []rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x := a[i]
+		y := b[i]
+		if x == y {
+			continue
+		}
+
+		x = foldRune(x)
+		y = foldRune(y)
+		if x < y {
+			return -1
+		}
+
+		if x > y {
+			return 1
+		}
+	}
+
+	return sign(len(a) - len(b))
+}
+
+// Sort sorts s in place in the order defined by Compare.
+func Sort(s [][]rune) {
+	slices.SortFunc(s, Compare)
+}
+
+// SortFold sorts s in place in the order defined by CompareFold. Slices that
+// are equal under EqualFold keep their original order.
+func SortFold(s [][]rune) {
+	slices.SortStableFunc(s, CompareFold)
+}
+
+// BinarySearch searches for target in s, which must be sorted as by Sort.
+// It returns the position where target is found, or the position where it
+// would be inserted, and whether it was found.
+func BinarySearch(s [][]rune, target []rune) (int, bool) {
+	return slices.BinarySearchFunc(s, target, Compare)
+}
+
+// BinarySearchFold searches for target in s, which must be sorted as by SortFold,
+// comparing with CompareFold. It returns the position of the first slice equal
+// to target under EqualFold, or the position where target would be inserted,
+// and whether it was found.
+func BinarySearchFold(s [][]rune, target []rune) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool {
+		return CompareFold(s[i], target) >= 0
+	})
+
+	return i, i < len(s) && CompareFold(s[i], target) == 0
+}
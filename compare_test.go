@@ -0,0 +1,76 @@
+package xrunes_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, 0, runes.Compare([]rune("test"), []rune("test")))
+	assert.Equal(t, -1, runes.Compare([]rune("TEST"), []rune("test")))
+	assert.Equal(t, 1, runes.Compare([]rune("test"), []rune("tes")))
+	assert.Equal(t, -1, runes.Compare(nil, []rune("a")))
+}
+
+func TestCompareFold(t *testing.T) {
+	assert.Equal(t, 0, runes.CompareFold([]rune("test"), []rune("TEST")))
+	assert.Equal(t, 0, runes.CompareFold([]rune("ǅungla"), []rune("ǆUNGLA")))
+	assert.Equal(t, -1, runes.CompareFold([]rune("Go"), []rune("GOPHER")))
+	assert.Equal(t, -1, runes.CompareFold([]rune("apple"), []rune("Banana")))
+	assert.Equal(t, 1, runes.CompareFold([]rune("b"), []rune("A")))
+	// k, K and the Kelvin sign are all equal under folding.
+	assert.Equal(t, 0, runes.CompareFold([]rune("K"), []rune("K")))
+	assert.True(t, runes.EqualFold([]rune("K"), []rune("K")))
+	assert.True(t, runes.EqualFold([]rune("K"), []rune("K")))
+}
+
+func TestCompareFoldConsistentWithEqualFold(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	alphabet := []rune("kKKsSſσςΣιΙιͅǅǄǆ1_")
+	gen := func() []rune {
+		r := make([]rune, rng.Intn(4))
+		for i := range r {
+			r[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return r
+	}
+
+	for i := 0; i < 5000; i++ {
+		a := gen()
+		b := gen()
+		c := gen()
+		ab := runes.CompareFold(a, b)
+		assert.Equal(t, runes.EqualFold(a, b), ab == 0, "%q %q", string(a), string(b))
+		assert.Equal(t, runes.EqualFold(a, b), runes.EqualFold(b, a), "%q %q", string(a), string(b))
+		assert.Equal(t, -ab, runes.CompareFold(b, a))
+		if ab <= 0 && runes.CompareFold(b, c) <= 0 {
+			assert.LessOrEqual(t, runes.CompareFold(a, c), 0)
+		}
+	}
+}
+
+func TestSortFold(t *testing.T) {
+	s := [][]rune{[]rune("banana"), []rune("Apple"), []rune("cherry"), []rune("apple"), []rune("BANANA")}
+	runes.SortFold(s)
+	assert.Equal(t, []string{"Apple", "apple", "banana", "BANANA", "cherry"}, toStrings(s))
+
+	i, ok := runes.BinarySearchFold(s, []rune("APPLE"))
+	assert.True(t, ok)
+	assert.Equal(t, 0, i)
+
+	i, ok = runes.BinarySearchFold(s, []rune("Blueberry"))
+	assert.False(t, ok)
+	assert.Equal(t, 4, i)
+
+	slices.SortFunc(s, runes.CompareFold)
+	runes.Sort(s)
+	assert.Equal(t, []string{"Apple", "BANANA", "apple", "banana", "cherry"}, toStrings(s))
+
+	i, ok = runes.BinarySearch(s, []rune("apple"))
+	assert.True(t, ok)
+	assert.Equal(t, 2, i)
+}
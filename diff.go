@@ -0,0 +1,425 @@
+package xrunes
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// EditOp is the kind of operation in an Edit.
+type EditOp int
+
+const (
+	// EditEqual keeps runes that are present in both inputs.
+	EditEqual EditOp = iota
+	// EditDelete removes runes that are only present in the first input.
+	EditDelete
+	// EditInsert adds runes that are only present in the second input.
+	EditInsert
+)
+
+// String returns the name of the operation.
+func (op EditOp) String() string {
+	switch op {
+	case EditEqual:
+		return "equal"
+	case EditDelete:
+		return "delete"
+	case EditInsert:
+		return "insert"
+	default:
+		return "EditOp(" + strconv.Itoa(int(op)) + ")"
+	}
+}
+
+// Edit is a single step of an edit script returned by Diff. Consecutive runes
+// with the same operation are grouped into a single Edit.
+type Edit struct {
+	// Op is the operation applied to Runes.
+	Op EditOp
+	// Runes are the runes kept, deleted or inserted by the operation.
+	Runes []rune
+}
+
+// Diff returns the shortest edit script that transforms a into b, computed
+// with Myers' O(ND) algorithm using the linear space divide and conquer
+// refinement. Applying the result to a with Patch yields b.
+//
+// Example:
+//
+//	edits := Diff([]rune("userName"), []rune("username"))
+//	// edits will be [{equal "user"} {delete "N"} {insert "n"} {equal "ame"}]
+func Diff(a []rune, b []rune) []Edit {
+	edits := make([]Edit, 0)
+	ai := 0
+	bi := 0
+	myers(a, b, func(op EditOp, n int) {
+		var r []rune
+		switch op {
+		case EditInsert:
+			r = b[bi : bi+n]
+			bi += n
+		case EditDelete:
+			r = a[ai : ai+n]
+			ai += n
+		default:
+			r = a[ai : ai+n]
+			ai += n
+			bi += n
+		}
+
+		if len(edits) > 0 && edits[len(edits)-1].Op == op {
+			last := &edits[len(edits)-1]
+			last.Runes = append(last.Runes[:len(last.Runes):len(last.Runes)], r...)
+			return
+		}
+
+		edits = append(edits, Edit{Op: op, Runes: r})
+	})
+
+	return edits
+}
+
+// Patch applies the edit script edits to a and returns the result. It returns
+// an error if the runes kept or deleted by edits do not match a.
+func Patch(a []rune, edits []Edit) ([]rune, error) {
+	sb := make([]rune, 0, len(a))
+	i := 0
+	for _, edit := range edits {
+		switch edit.Op {
+		case EditInsert:
+			sb = append(sb, edit.Runes...)
+		case EditEqual, EditDelete:
+			n := len(edit.Runes)
+			if i+n > len(a) || !Equal(a[i:i+n], edit.Runes) {
+				return nil, fmt.Errorf("xrunes: patch %s of %q does not match input at offset %d", edit.Op, string(edit.Runes), i)
+			}
+
+			if edit.Op == EditEqual {
+				sb = append(sb, edit.Runes...)
+			}
+
+			i += n
+		default:
+			return nil, fmt.Errorf("xrunes: unknown patch operation %s", edit.Op)
+		}
+	}
+
+	if i != len(a) {
+		return nil, fmt.Errorf("xrunes: patch does not consume input, %d runes left at offset %d", len(a)-i, i)
+	}
+
+	return sb, nil
+}
+
+// FormatInline renders edits on a single line, wrapping deleted runes in
+// "[-" and "-]" and inserted runes in "{+" and "+}", as git's word diff does.
+//
+// Example:
+//
+//	s := FormatInline(Diff([]rune("userName"), []rune("username")))
+//	// s will be "user[-N-]{+n+}ame"
+func FormatInline(edits []Edit) []rune {
+	sb := make([]rune, 0)
+	for _, edit := range edits {
+		switch edit.Op {
+		case EditDelete:
+			sb = append(sb, '[', '-')
+			sb = append(sb, edit.Runes...)
+			sb = append(sb, '-', ']')
+		case EditInsert:
+			sb = append(sb, '{', '+')
+			sb = append(sb, edit.Runes...)
+			sb = append(sb, '+', '}')
+		default:
+			sb = append(sb, edit.Runes...)
+		}
+	}
+
+	return sb
+}
+
+// FormatUnified renders the texts described by edits as unified diff hunks
+// with the given number of context lines. The texts are compared line by
+// line, so the output can be read by patch(1) and diff viewers. It returns an
+// empty slice when both texts are equal.
+func FormatUnified(edits []Edit, context int) []rune {
+	if context < 0 {
+		context = 0
+	}
+
+	a := make([]rune, 0)
+	b := make([]rune, 0)
+	for _, edit := range edits {
+		if edit.Op != EditInsert {
+			a = append(a, edit.Runes...)
+		}
+
+		if edit.Op != EditDelete {
+			b = append(b, edit.Runes...)
+		}
+	}
+
+	al := splitLines(a)
+	bl := splitLines(b)
+
+	type line struct {
+		op   EditOp
+		text string
+	}
+
+	lines := make([]line, 0, len(al)+len(bl))
+	ai := 0
+	bi := 0
+	myers(al, bl, func(op EditOp, n int) {
+		for range n {
+			switch op {
+			case EditInsert:
+				lines = append(lines, line{op, bl[bi]})
+				bi++
+			case EditDelete:
+				lines = append(lines, line{op, al[ai]})
+				ai++
+			default:
+				lines = append(lines, line{op, al[ai]})
+				ai++
+				bi++
+			}
+		}
+	})
+
+	sb := make([]rune, 0)
+	// aLine and bLine are the 0-based line numbers at the start of lines[i].
+	aLine := make([]int, len(lines)+1)
+	bLine := make([]int, len(lines)+1)
+	for i, l := range lines {
+		aLine[i+1] = aLine[i]
+		bLine[i+1] = bLine[i]
+		if l.op != EditInsert {
+			aLine[i+1]++
+		}
+
+		if l.op != EditDelete {
+			bLine[i+1]++
+		}
+	}
+
+	i := 0
+	for i < len(lines) {
+		if lines[i].op == EditEqual {
+			i++
+			continue
+		}
+
+		start := max(0, i-context)
+		end := i
+		for end < len(lines) {
+			if lines[end].op != EditEqual {
+				end++
+				continue
+			}
+
+			next := end
+			for next < len(lines) && lines[next].op == EditEqual {
+				next++
+			}
+
+			if next == len(lines) || next-end > 2*context {
+				end = min(len(lines), end+context)
+				break
+			}
+
+			end = next
+		}
+
+		aCount := aLine[end] - aLine[start]
+		bCount := bLine[end] - bLine[start]
+		aStart := aLine[start]
+		if aCount > 0 {
+			aStart++
+		}
+
+		bStart := bLine[start]
+		if bCount > 0 {
+			bStart++
+		}
+
+		header := fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", aStart, aCount, bStart, bCount)
+		sb = append(sb, []rune(header)...)
+		for _, l := range lines[start:end] {
+			switch l.op {
+			case EditDelete:
+				sb = append(sb, '-')
+			case EditInsert:
+				sb = append(sb, '+')
+			default:
+				sb = append(sb, ' ')
+			}
+
+			sb = append(sb, []rune(l.text)...)
+			if len(l.text) == 0 || l.text[len(l.text)-1] != '\n' {
+				sb = append(sb, []rune("\n\\ No newline at end of file\n")...)
+			}
+		}
+
+		i = end
+	}
+
+	return sb
+}
+
+// splitLines splits s after each '\n'. The last line has no trailing newline
+// when s does not end with one.
+func splitLines(s []rune) []string {
+	lines := make([]string, 0)
+	start := 0
+	for i, r := range s {
+		if r == '\n' {
+			lines = append(lines, string(s[start:i+1]))
+			start = i + 1
+		}
+	}
+
+	if start < len(s) {
+		lines = append(lines, string(s[start:]))
+	}
+
+	return lines
+}
+
+// myers computes the shortest edit script between a and b and reports it to
+// emit as runs of operations, in order.
+func myers[T comparable](a, b []T, emit func(op EditOp, n int)) {
+	p := 0
+	for p < len(a) && p < len(b) && a[p] == b[p] {
+		p++
+	}
+
+	if p > 0 {
+		emit(EditEqual, p)
+	}
+
+	a = a[p:]
+	b = b[p:]
+
+	s := 0
+	for s < len(a) && s < len(b) && a[len(a)-1-s] == b[len(b)-1-s] {
+		s++
+	}
+
+	a = a[:len(a)-s]
+	b = b[:len(b)-s]
+
+	switch {
+	case len(a) == 0 && len(b) == 0:
+	case len(a) == 0:
+		emit(EditInsert, len(b))
+	case len(b) == 0:
+		emit(EditDelete, len(a))
+	default:
+		x, y := middleSnake(a, b)
+		if x < 0 {
+			emit(EditDelete, len(a))
+			emit(EditInsert, len(b))
+			break
+		}
+
+		myers(a[:x], b[:y], emit)
+		myers(a[x:], b[y:], emit)
+	}
+
+	if s > 0 {
+		emit(EditEqual, s)
+	}
+}
+
+// middleSnake runs the forward and reverse searches of Myers' algorithm
+// simultaneously and returns the point where they overlap, which splits the
+// problem into two halves. It returns -1, -1 when a and b have nothing in common.
+func middleSnake[T comparable](a, b []T) (int, int) {
+	n := len(a)
+	m := len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	size := 2*maxD + 2
+	v1 := make([]int, size)
+	v2 := make([]int, size)
+	for i := range v1 {
+		v1[i] = -1
+		v2[i] = -1
+	}
+
+	v1[offset+1] = 0
+	v2[offset+1] = 0
+	delta := n - m
+	// when the delta is odd the forward path overlaps the reverse one.
+	front := delta%2 != 0
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+
+	for d := 0; d < maxD; d++ {
+		for k1 := -d + k1start; k1 <= d-k1end; k1 += 2 {
+			k1Off := offset + k1
+			var x1 int
+			if k1 == -d || (k1 != d && v1[k1Off-1] < v1[k1Off+1]) {
+				x1 = v1[k1Off+1]
+			} else {
+				x1 = v1[k1Off-1] + 1
+			}
+
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+
+			v1[k1Off] = x1
+			switch {
+			case x1 > n:
+				k1end += 2
+			case y1 > m:
+				k1start += 2
+			case front:
+				k2Off := offset + delta - k1
+				if k2Off >= 0 && k2Off < size && v2[k2Off] != -1 {
+					if x1 >= n-v2[k2Off] {
+						return x1, y1
+					}
+				}
+			}
+		}
+
+		for k2 := -d + k2start; k2 <= d-k2end; k2 += 2 {
+			k2Off := offset + k2
+			var x2 int
+			if k2 == -d || (k2 != d && v2[k2Off-1] < v2[k2Off+1]) {
+				x2 = v2[k2Off+1]
+			} else {
+				x2 = v2[k2Off-1] + 1
+			}
+
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+
+			v2[k2Off] = x2
+			switch {
+			case x2 > n:
+				k2end += 2
+			case y2 > m:
+				k2start += 2
+			case !front:
+				k1Off := offset + delta - k2
+				if k1Off >= 0 && k1Off < size && v1[k1Off] != -1 {
+					x1 := v1[k1Off]
+					y1 := offset + x1 - k1Off
+					if x1 >= n-x2 {
+						return x1, y1
+					}
+				}
+			}
+		}
+	}
+
+	return -1, -1
+}
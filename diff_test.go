@@ -0,0 +1,104 @@
+package xrunes_test
+
+import (
+	"math/rand"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	edits := runes.Diff([]rune("userName"), []rune("username"))
+	assert.Equal(t, []runes.Edit{
+		{Op: runes.EditEqual, Runes: []rune("user")},
+		{Op: runes.EditDelete, Runes: []rune("N")},
+		{Op: runes.EditInsert, Runes: []rune("n")},
+		{Op: runes.EditEqual, Runes: []rune("ame")},
+	}, edits)
+
+	assert.Empty(t, runes.Diff([]rune(""), []rune("")))
+	assert.Equal(t, []runes.Edit{{Op: runes.EditInsert, Runes: []rune("abc")}}, runes.Diff(nil, []rune("abc")))
+	assert.Equal(t, []runes.Edit{{Op: runes.EditDelete, Runes: []rune("abc")}}, runes.Diff([]rune("abc"), nil))
+	assert.Equal(t, []runes.Edit{{Op: runes.EditEqual, Runes: []rune("héllo")}}, runes.Diff([]rune("héllo"), []rune("héllo")))
+}
+
+func lcsLength(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				cur[j] = prev[j-1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j-1])
+			}
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(b)]
+}
+
+func TestDiffIsMinimalAndPatches(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	alphabet := []rune("abcdé")
+	gen := func() []rune {
+		r := make([]rune, rng.Intn(30))
+		for i := range r {
+			r[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return r
+	}
+
+	for i := 0; i < 1000; i++ {
+		a := gen()
+		b := gen()
+		edits := runes.Diff(a, b)
+
+		patched, err := runes.Patch(a, edits)
+		assert.NoError(t, err)
+		assert.Equal(t, string(b), string(patched))
+
+		changed := 0
+		for _, e := range edits {
+			if e.Op != runes.EditEqual {
+				changed += len(e.Runes)
+			}
+		}
+
+		assert.Equal(t, len(a)+len(b)-2*lcsLength(a, b), changed, "%q -> %q", string(a), string(b))
+	}
+}
+
+func TestPatchMismatch(t *testing.T) {
+	edits := runes.Diff([]rune("abc"), []rune("abd"))
+	_, err := runes.Patch([]rune("xbc"), edits)
+	assert.Error(t, err)
+
+	_, err = runes.Patch([]rune("abcz"), edits)
+	assert.Error(t, err)
+}
+
+func TestFormatInline(t *testing.T) {
+	edits := runes.Diff([]rune("userName"), []rune("username"))
+	assert.Equal(t, "user[-N-]{+n+}ame", string(runes.FormatInline(edits)))
+}
+
+func TestFormatUnified(t *testing.T) {
+	a := []rune("one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\n")
+	b := []rune("one\n2\nthree\nfour\nfive\nsix\nseven\neight\nnine")
+	expected := "@@ -1,3 +1,3 @@\n" +
+		" one\n" +
+		"-two\n" +
+		"+2\n" +
+		" three\n" +
+		"@@ -8,1 +8,2 @@\n" +
+		" eight\n" +
+		"+nine\n" +
+		"\\ No newline at end of file\n"
+
+	assert.Equal(t, expected, string(runes.FormatUnified(runes.Diff(a, b), 1)))
+	assert.Empty(t, runes.FormatUnified(runes.Diff(a, a), 3))
+}
@@ -0,0 +1,292 @@
+package xrunes
+
+// Levenshtein returns the Levenshtein edit distance between a and b, which is
+// the minimum number of single rune insertions, deletions and substitutions
+// required to change a into b.
+//
+// Example:
+//
+//	d := Levenshtein([]rune("kitten"), []rune("sitting")) // d will be 3
+func Levenshtein(a []rune, b []rune) int {
+	return levenshtein(a, b, equalRune)
+}
+
+// LevenshteinFold returns the Levenshtein edit distance between a and b,
+// using Unicode case-folding to compare runes the same way EqualFold does.
+func LevenshteinFold(a []rune, b []rune) int {
+	return levenshtein(a, b, equalRuneFold)
+}
+
+// OSADistance returns the optimal string alignment distance between a and b.
+// It extends Levenshtein with transpositions of two adjacent runes, with the
+// restriction that no substring is edited more than once.
+//
+// Example:
+//
+//	d := OSADistance([]rune("ca"), []rune("abc")) // d will be 3
+func OSADistance(a []rune, b []rune) int {
+	return osaDistance(a, b, equalRune)
+}
+
+// OSADistanceFold returns the optimal string alignment distance between a and b,
+// using Unicode case-folding to compare runes.
+func OSADistanceFold(a []rune, b []rune) int {
+	return osaDistance(a, b, equalRuneFold)
+}
+
+// DamerauLevenshtein returns the unrestricted Damerau–Levenshtein distance
+// between a and b. Unlike OSADistance, a transposed pair may be edited again,
+// so the result is a true metric.
+//
+// Example:
+//
+//	d := DamerauLevenshtein([]rune("ca"), []rune("abc")) // d will be 2
+func DamerauLevenshtein(a []rune, b []rune) int {
+	return damerauLevenshtein(a, b, equalRune, identityRune)
+}
+
+// DamerauLevenshteinFold returns the unrestricted Damerau–Levenshtein distance
+// between a and b, using Unicode case-folding to compare runes.
+func DamerauLevenshteinFold(a []rune, b []rune) int {
+	return damerauLevenshtein(a, b, equalRuneFold, foldRune)
+}
+
+// DistanceAtMost reports the Levenshtein distance between a and b if it does
+// not exceed k. It returns false as soon as the distance is known to be greater
+// than k, which makes it much cheaper than Levenshtein for long inputs when only
+// close matches are interesting.
+//
+// Only a diagonal band of width 2k+1 of the dynamic programming matrix is
+// evaluated (Ukkonen's cut-off), giving O(k·min(len(a), len(b))) time.
+//
+// Example:
+//
+//	d, ok := DistanceAtMost([]rune("kitten"), []rune("sitting"), 2) // ok will be false
+//	d, ok = DistanceAtMost([]rune("kitten"), []rune("sitting"), 3)  // d will be 3, ok will be true
+func DistanceAtMost(a []rune, b []rune, k int) (int, bool) {
+	return levenshteinAtMost(a, b, k, equalRune)
+}
+
+// DistanceAtMostFold is like DistanceAtMost but uses Unicode case-folding to
+// compare runes.
+func DistanceAtMostFold(a []rune, b []rune, k int) (int, bool) {
+	return levenshteinAtMost(a, b, k, equalRuneFold)
+}
+
+func identityRune(r rune) rune {
+	return r
+}
+
+// trimCommon removes the common prefix and suffix of a and b, which never
+// contribute to the edit distance.
+func trimCommon(a, b []rune, eq func(x, y rune) bool) ([]rune, []rune) {
+	for len(a) > 0 && len(b) > 0 && eq(a[0], b[0]) {
+		a = a[1:]
+		b = b[1:]
+	}
+
+	for len(a) > 0 && len(b) > 0 && eq(a[len(a)-1], b[len(b)-1]) {
+		a = a[:len(a)-1]
+		b = b[:len(b)-1]
+	}
+
+	return a, b
+}
+
+func levenshtein(a, b []rune, eq func(x, y rune) bool) int {
+	a, b = trimCommon(a, b, eq)
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	if len(a) == 0 {
+		return len(b)
+	}
+
+	row := make([]int, len(a)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	for j := 1; j <= len(b); j++ {
+		diag := row[0]
+		row[0] = j
+		for i := 1; i <= len(a); i++ {
+			cost := 1
+			if eq(a[i-1], b[j-1]) {
+				cost = 0
+			}
+
+			next := min(row[i]+1, row[i-1]+1, diag+cost)
+			diag = row[i]
+			row[i] = next
+		}
+	}
+
+	return row[len(a)]
+}
+
+func levenshteinAtMost(a, b []rune, k int, eq func(x, y rune) bool) (int, bool) {
+	if k < 0 {
+		return 0, false
+	}
+
+	a, b = trimCommon(a, b, eq)
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+
+	n := len(a)
+	m := len(b)
+	if m-n > k {
+		return 0, false
+	}
+
+	if n == 0 {
+		return m, true
+	}
+
+	// Cells outside of the band are treated as k+1, which is enough to
+	// signal that a path through them cannot stay within the bound.
+	inf := k + 1
+	prev := make([]int, m+1)
+	cur := make([]int, m+1)
+	for j := range prev {
+		prev[j] = min(j, inf)
+	}
+
+	for i := 1; i <= n; i++ {
+		lo := max(1, i-k)
+		hi := min(m, i+k)
+
+		rowMin := inf
+		if lo == 1 {
+			cur[0] = min(i, inf)
+			rowMin = cur[0]
+		} else {
+			cur[lo-1] = inf
+		}
+
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if eq(a[i-1], b[j-1]) {
+				cost = 0
+			}
+
+			v := min(prev[j-1]+cost, prev[j]+1, cur[j-1]+1, inf)
+			cur[j] = v
+			if v < rowMin {
+				rowMin = v
+			}
+		}
+
+		if hi < m {
+			cur[hi+1] = inf
+		}
+
+		if rowMin > k {
+			return 0, false
+		}
+
+		prev, cur = cur, prev
+	}
+
+	if prev[m] > k {
+		return 0, false
+	}
+
+	return prev[m], true
+}
+
+func osaDistance(a, b []rune, eq func(x, y rune) bool) int {
+	a, b = trimCommon(a, b, eq)
+	n := len(a)
+	m := len(b)
+	if n == 0 {
+		return m
+	}
+
+	if m == 0 {
+		return n
+	}
+
+	prev2 := make([]int, m+1)
+	prev := make([]int, m+1)
+	cur := make([]int, m+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= n; i++ {
+		cur[0] = i
+		for j := 1; j <= m; j++ {
+			cost := 1
+			if eq(a[i-1], b[j-1]) {
+				cost = 0
+			}
+
+			v := min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && eq(a[i-1], b[j-2]) && eq(a[i-2], b[j-1]) {
+				v = min(v, prev2[j-2]+1)
+			}
+
+			cur[j] = v
+		}
+
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[m]
+}
+
+func damerauLevenshtein(a, b []rune, eq func(x, y rune) bool, key func(r rune) rune) int {
+	n := len(a)
+	m := len(b)
+	if n == 0 {
+		return m
+	}
+
+	if m == 0 {
+		return n
+	}
+
+	// last row in which each (keyed) rune of a was seen.
+	da := make(map[rune]int)
+	inf := n + m
+	width := m + 2
+	d := make([]int, (n+2)*width)
+	d[0] = inf
+	for i := 0; i <= n; i++ {
+		d[(i+1)*width] = inf
+		d[(i+1)*width+1] = i
+	}
+
+	for j := 0; j <= m; j++ {
+		d[j+1] = inf
+		d[width+j+1] = j
+	}
+
+	for i := 1; i <= n; i++ {
+		db := 0
+		for j := 1; j <= m; j++ {
+			i1 := da[key(b[j-1])]
+			j1 := db
+			cost := 1
+			if eq(a[i-1], b[j-1]) {
+				cost = 0
+				db = j
+			}
+
+			d[(i+1)*width+j+1] = min(
+				d[i*width+j]+cost,
+				d[(i+1)*width+j]+1,
+				d[i*width+j+1]+1,
+				d[i1*width+j1]+(i-i1-1)+1+(j-j1-1),
+			)
+		}
+
+		da[key(a[i-1])] = i
+	}
+
+	return d[(n+1)*width+m+1]
+}
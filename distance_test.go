@@ -0,0 +1,141 @@
+package xrunes_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, runes.Levenshtein([]rune(""), []rune("")))
+	assert.Equal(t, 4, runes.Levenshtein([]rune(""), []rune("test")))
+	assert.Equal(t, 4, runes.Levenshtein([]rune("test"), []rune("")))
+	assert.Equal(t, 3, runes.Levenshtein([]rune("kitten"), []rune("sitting")))
+	assert.Equal(t, 2, runes.Levenshtein([]rune("flaw"), []rune("lawn")))
+	assert.Equal(t, 1, runes.Levenshtein([]rune("crème"), []rune("creme")))
+	assert.Equal(t, 4, runes.Levenshtein([]rune("test"), []rune("TEST")))
+	assert.Equal(t, 2, runes.Levenshtein([]rune("ab"), []rune("ba")))
+}
+
+func TestLevenshteinFold(t *testing.T) {
+	assert.Equal(t, 0, runes.LevenshteinFold([]rune("test"), []rune("TEST")))
+	assert.Equal(t, 1, runes.LevenshteinFold([]rune("Tesst"), []rune("test")))
+	assert.Equal(t, 3, runes.LevenshteinFold([]rune("KITTEN"), []rune("sitting")))
+	assert.Equal(t, 1, runes.LevenshteinFold([]rune("test1"), []rune("TEST2")))
+}
+
+func TestOSADistance(t *testing.T) {
+	assert.Equal(t, 1, runes.OSADistance([]rune("ab"), []rune("ba")))
+	assert.Equal(t, 3, runes.OSADistance([]rune("ca"), []rune("abc")))
+	assert.Equal(t, 1, runes.OSADistance([]rune("statsu"), []rune("status")))
+	assert.Equal(t, 3, runes.OSADistance([]rune("kitten"), []rune("sitting")))
+	assert.Equal(t, 1, runes.OSADistanceFold([]rune("AB"), []rune("ba")))
+}
+
+func TestDamerauLevenshtein(t *testing.T) {
+	assert.Equal(t, 1, runes.DamerauLevenshtein([]rune("ab"), []rune("ba")))
+	assert.Equal(t, 2, runes.DamerauLevenshtein([]rune("ca"), []rune("abc")))
+	assert.Equal(t, 3, runes.DamerauLevenshtein([]rune("kitten"), []rune("sitting")))
+	assert.Equal(t, 5, runes.DamerauLevenshtein([]rune(""), []rune("hello")))
+	assert.Equal(t, 2, runes.DamerauLevenshteinFold([]rune("CA"), []rune("abc")))
+	assert.Equal(t, 0, runes.DamerauLevenshteinFold([]rune("Hello"), []rune("hELLO")))
+}
+
+func TestDistanceAtMost(t *testing.T) {
+	d, ok := runes.DistanceAtMost([]rune("kitten"), []rune("sitting"), 3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, d)
+
+	_, ok = runes.DistanceAtMost([]rune("kitten"), []rune("sitting"), 2)
+	assert.False(t, ok)
+
+	_, ok = runes.DistanceAtMost([]rune("a"), []rune("abcdef"), 4)
+	assert.False(t, ok)
+
+	d, ok = runes.DistanceAtMost([]rune(""), []rune("abc"), 3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, d)
+
+	d, ok = runes.DistanceAtMostFold([]rune("Status"), []rune("STATSU"), 2)
+	assert.True(t, ok)
+	assert.Equal(t, 2, d)
+
+	_, ok = runes.DistanceAtMost([]rune("a"), []rune("a"), -1)
+	assert.False(t, ok)
+}
+
+func TestDistanceAtMostMatchesLevenshtein(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	alphabet := []rune("abcAB")
+	gen := func() []rune {
+		r := make([]rune, rng.Intn(12))
+		for i := range r {
+			r[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return r
+	}
+
+	for i := 0; i < 2000; i++ {
+		a := gen()
+		b := gen()
+		k := rng.Intn(8)
+		want := runes.Levenshtein(a, b)
+		d, ok := runes.DistanceAtMost(a, b, k)
+		assert.Equal(t, want <= k, ok, "%q %q k=%d", string(a), string(b), k)
+		if ok {
+			assert.Equal(t, want, d, "%q %q k=%d", string(a), string(b), k)
+		}
+
+		want = runes.LevenshteinFold(a, b)
+		d, ok = runes.DistanceAtMostFold(a, b, k)
+		assert.Equal(t, want <= k, ok, "%q %q k=%d", string(a), string(b), k)
+		if ok {
+			assert.Equal(t, want, d)
+		}
+
+		assert.LessOrEqual(t, runes.DamerauLevenshtein(a, b), runes.OSADistance(a, b))
+		assert.LessOrEqual(t, runes.OSADistance(a, b), runes.Levenshtein(a, b))
+	}
+}
+
+func longRunes(seed int64, n int) []rune {
+	rng := rand.New(rand.NewSource(seed))
+	words := strings.Fields("alpha beta gamma delta epsilon zeta eta theta iota kappa")
+	var sb strings.Builder
+	for sb.Len() < n {
+		sb.WriteString(words[rng.Intn(len(words))])
+		sb.WriteByte(' ')
+	}
+
+	return []rune(sb.String()[:n])
+}
+
+func BenchmarkLevenshtein(b *testing.B) {
+	x := longRunes(1, 2000)
+	y := longRunes(2, 2000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runes.Levenshtein(x, y)
+	}
+}
+
+func BenchmarkDistanceAtMost(b *testing.B) {
+	x := longRunes(1, 2000)
+	y := append(append([]rune{}, x[:1000]...), x[1003:]...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runes.DistanceAtMost(x, y, 8)
+	}
+}
+
+func BenchmarkDamerauLevenshtein(b *testing.B) {
+	x := longRunes(1, 500)
+	y := longRunes(2, 500)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runes.DamerauLevenshtein(x, y)
+	}
+}
@@ -0,0 +1,261 @@
+// Package envname maps configuration field paths, such as Database.MaxConns,
+// to environment variable names, such as APP_DATABASE_MAX_CONNS, and back.
+//
+// Names are built with xrunes.Underscore and the Screaming option, so they
+// split words on the same boundaries as the rest of the module: an acronym
+// followed by a word, such as HTTPServer, is one word unless the acronym is
+// registered with Acronyms.
+package envname
+
+import (
+	"slices"
+	"unicode"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+)
+
+// Params defines the parameters used by a Mapper.
+type Params struct {
+	// Acronyms are words that are kept together even when their case would
+	// split them, such as "IDs" or "URLs", and that are restored with their
+	// spelling when mapping names back to field paths.
+	Acronyms [][]rune
+}
+
+// Option is a function type that modifies the options for Params.
+type Option func(params *Params)
+
+// Acronyms returns an Option that registers words that must not be split.
+func Acronyms(acronyms ...[]rune) Option {
+	return func(params *Params) {
+		params.Acronyms = append(params.Acronyms, acronyms...)
+	}
+}
+
+// Mapper maps field paths to environment variable names under a prefix.
+// A Mapper is immutable and safe for concurrent use.
+type Mapper struct {
+	prefix []rune
+	params Params
+}
+
+// New creates a Mapper for the given prefix. The prefix is converted to
+// screaming snake case; an empty prefix produces names without one.
+//
+// Example:
+//
+//	m := New([]rune("app"), Acronyms([]rune("IDs")))
+//	m.Name([]rune("Database"), []rune("MaxConns")) // "APP_DATABASE_MAX_CONNS"
+//	m.Name([]rune("UserIDs"))                      // "APP_USER_IDS"
+func New(prefix []rune, options ...Option) *Mapper {
+	m := &Mapper{prefix: xrunes.Underscore(prefix, xrunes.Screaming)}
+	for _, option := range options {
+		option(&m.params)
+	}
+
+	return m
+}
+
+// Name returns the environment variable name of the field path for prefix,
+// using a Mapper without options.
+func Name(prefix []rune, path ...[]rune) []rune {
+	return New(prefix).Name(path...)
+}
+
+// Name returns the environment variable name of the field path. Each field is
+// converted to screaming snake case and the prefix and fields are joined with '_'.
+func (m *Mapper) Name(path ...[]rune) []rune {
+	sb := make([]rune, 0, len(m.prefix)+16*len(path))
+	sb = append(sb, m.prefix...)
+	for _, field := range path {
+		for _, word := range m.words(field) {
+			if len(sb) > 0 {
+				sb = append(sb, '_')
+			}
+
+			sb = append(sb, word...)
+		}
+	}
+
+	return sb
+}
+
+// MaxCandidateWords is the largest number of words, after the prefix, of a
+// name that Candidates expands. Longer names have too many candidates to be
+// enumerated.
+const MaxCandidateWords = 16
+
+// Candidates returns every field path that could have produced name, in
+// PascalCase. Since the separators between fields and between words of a
+// field are the same, a name of n words has 2^(n-1) candidates, ordered from
+// the one with the fewest fields to the one with the most. It returns nil when
+// name does not start with the prefix of the Mapper, or when it has more than
+// MaxCandidateWords words; use Resolve to match such names against known paths.
+//
+// Example:
+//
+//	New([]rune("APP")).Candidates([]rune("APP_DATABASE_MAX_CONNS"))
+//	// [[DatabaseMaxConns] [DatabaseMax Conns] [Database MaxConns] [Database Max Conns]]
+func (m *Mapper) Candidates(name []rune) [][][]rune {
+	words, ok := m.split(name)
+	if !ok || len(words) == 0 || len(words) > MaxCandidateWords {
+		return nil
+	}
+
+	n := len(words) - 1
+	candidates := make([][][]rune, 0, 1<<n)
+	for breaks := 0; breaks <= n; breaks++ {
+		// each bit of mask tells whether a field ends after that word. The
+		// masks with the same number of bits are visited in increasing order
+		// by taking the next larger number with as many bits set.
+		for mask := 1<<breaks - 1; mask < 1<<n; {
+			path := make([][]rune, 0, breaks+1)
+			field := make([]rune, 0)
+			for i, word := range words {
+				field = append(field, m.pascal(word)...)
+				if i == n || mask&(1<<(n-1-i)) != 0 {
+					path = append(path, field)
+					field = make([]rune, 0)
+				}
+			}
+
+			candidates = append(candidates, path)
+			if mask == 0 {
+				break
+			}
+
+			low := mask & -mask
+			ripple := mask + low
+			mask = ripple | (mask^ripple)/low>>2
+		}
+	}
+
+	return candidates
+}
+
+// Resolve returns the paths among known whose name is name, compared with
+// xrunes.EqualFold. More than one path is returned when the mapping is
+// ambiguous, for example between Database.MaxConns and DatabaseMax.Conns.
+func (m *Mapper) Resolve(name []rune, known ...[][]rune) [][][]rune {
+	found := make([][][]rune, 0)
+	for _, path := range known {
+		if xrunes.EqualFold(m.Name(path...), name) {
+			found = append(found, path)
+		}
+	}
+
+	return found
+}
+
+// words splits field into screaming snake case words, keeping acronyms whole.
+func (m *Mapper) words(field []rune) [][]rune {
+	words := make([][]rune, 0)
+	appendWords := func(s []rune) {
+		u := xrunes.Underscore(s, xrunes.Screaming)
+		start := 0
+		for i := 0; i <= len(u); i++ {
+			if i == len(u) || u[i] == '_' {
+				if i > start {
+					words = append(words, u[start:i])
+				}
+
+				start = i + 1
+			}
+		}
+	}
+
+	start := 0
+	for i := 0; i < len(field); i++ {
+		acronym := m.acronymAt(field, i)
+		if acronym == nil {
+			continue
+		}
+
+		appendWords(field[start:i])
+		upper := make([]rune, len(acronym))
+		for j, r := range acronym {
+			upper[j] = unicode.ToUpper(r)
+		}
+
+		words = append(words, upper)
+		i += len(acronym) - 1
+		start = i + 1
+	}
+
+	appendWords(field[start:])
+	return words
+}
+
+// acronymAt returns the acronym found at index i of field when it forms a
+// whole word: it is preceded by the start of the field, a lowercase letter or
+// a separator, and followed by the end of the field, an uppercase letter, a
+// digit or a separator.
+func (m *Mapper) acronymAt(field []rune, i int) []rune {
+	for _, acronym := range m.params.Acronyms {
+		if len(acronym) == 0 || !xrunes.HasPrefix(field[i:], acronym) {
+			continue
+		}
+
+		if i > 0 && unicode.IsUpper(field[i-1]) {
+			continue
+		}
+
+		end := i + len(acronym)
+		if end < len(field) && unicode.IsLower(field[end]) {
+			continue
+		}
+
+		return acronym
+	}
+
+	return nil
+}
+
+// split removes the prefix from name and splits the rest on '_'.
+func (m *Mapper) split(name []rune) ([][]rune, bool) {
+	if len(m.prefix) > 0 {
+		if !xrunes.HasPrefixFold(name, m.prefix) {
+			return nil, false
+		}
+
+		name = name[len(m.prefix):]
+		if len(name) > 0 && name[0] != '_' {
+			return nil, false
+		}
+	}
+
+	words := make([][]rune, 0)
+	start := 0
+	for i := 0; i <= len(name); i++ {
+		if i == len(name) || name[i] == '_' {
+			if i > start {
+				words = append(words, name[start:i])
+			}
+
+			start = i + 1
+		}
+	}
+
+	return words, true
+}
+
+// pascal returns word with its first rune in uppercase and the others in
+// lowercase, or the spelling of the matching acronym.
+func (m *Mapper) pascal(word []rune) []rune {
+	for _, acronym := range m.params.Acronyms {
+		if xrunes.EqualFold(acronym, word) {
+			return slices.Clone(acronym)
+		}
+	}
+
+	s := make([]rune, len(word))
+	for i, r := range word {
+		if i == 0 {
+			s[i] = unicode.ToUpper(r)
+		} else {
+			s[i] = unicode.ToLower(r)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,125 @@
+package envname_test
+
+import (
+	"strings"
+	"testing"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+	"github.com/jolt9dev/go-xrunes/envname"
+	"github.com/stretchr/testify/assert"
+)
+
+func paths(candidates [][][]rune) [][]string {
+	r := make([][]string, len(candidates))
+	for i, path := range candidates {
+		r[i] = make([]string, len(path))
+		for j, field := range path {
+			r[i][j] = string(field)
+		}
+	}
+
+	return r
+}
+
+func TestName(t *testing.T) {
+	assert.Equal(t, "APP_DATABASE_MAX_CONNS", string(envname.Name([]rune("app"), []rune("Database"), []rune("MaxConns"))))
+	assert.Equal(t, "APP_HTTPSERVER_READ_TIMEOUT", string(envname.Name([]rune("App"), []rune("HTTPServer"), []rune("ReadTimeout"))))
+	m := envname.New([]rune("App"), envname.Acronyms([]rune("HTTP")))
+	assert.Equal(t, "APP_HTTP_SERVER_READ_TIMEOUT", string(m.Name([]rune("HTTPServer"), []rune("ReadTimeout"))))
+	assert.Equal(t, "MY_APP_LOG_LEVEL", string(envname.Name([]rune("my-app"), []rune("log_level"))))
+	assert.Equal(t, "PORT", string(envname.Name(nil, []rune("Port"))))
+	assert.Equal(t, "APP", string(envname.Name([]rune("APP"))))
+
+	// consistent with Underscore and Screaming.
+	field := []rune("XMLParserMaxDepth2")
+	assert.Equal(t, "APP_"+string(xrunes.Underscore(field, xrunes.Screaming)), string(envname.Name([]rune("APP"), field)))
+}
+
+func TestNameAcronyms(t *testing.T) {
+	assert.Equal(t, "APP_USER_IDS", string(envname.Name([]rune("APP"), []rune("UserIDs"))))
+	assert.Equal(t, "APP_IDSET", string(envname.Name([]rune("APP"), []rune("IDSet"))))
+
+	m := envname.New([]rune("APP"), envname.Acronyms([]rune("IDs"), []rune("URLs")))
+	assert.Equal(t, "APP_USER_IDS", string(m.Name([]rune("UserIDs"))))
+	assert.Equal(t, "APP_IDS_COUNT", string(m.Name([]rune("IDsCount"))))
+	assert.Equal(t, "APP_CALLBACK_URLS", string(m.Name([]rune("Callback"), []rune("URLs"))))
+	// "IDs" inside "PIDs" is not a whole word.
+	assert.Equal(t, "APP_PIDS", string(m.Name([]rune("PIDs"))))
+}
+
+func TestCandidates(t *testing.T) {
+	m := envname.New([]rune("APP"))
+	assert.Equal(t, [][]string{
+		{"DatabaseMaxConns"},
+		{"DatabaseMax", "Conns"},
+		{"Database", "MaxConns"},
+		{"Database", "Max", "Conns"},
+	}, paths(m.Candidates([]rune("APP_DATABASE_MAX_CONNS"))))
+
+	assert.Nil(t, m.Candidates([]rune("OTHER_DATABASE")))
+	assert.Nil(t, m.Candidates([]rune("APPLE_PIE")))
+	assert.Nil(t, m.Candidates([]rune("APP")))
+	assert.Equal(t, [][]string{{"Port"}}, paths(envname.New(nil).Candidates([]rune("PORT"))))
+
+	m = envname.New([]rune("APP"), envname.Acronyms([]rune("IDs")))
+	assert.Equal(t, [][]string{{"UserIDs"}, {"User", "IDs"}}, paths(m.Candidates([]rune("app_user_ids"))))
+}
+
+func TestCandidatesLong(t *testing.T) {
+	m := envname.New([]rune("APP"))
+	name := func(n int) []rune {
+		words := make([]string, n)
+		for i := range words {
+			words[i] = "W"
+		}
+
+		return []rune("APP_" + strings.Join(words, "_"))
+	}
+
+	candidates := m.Candidates(name(envname.MaxCandidateWords))
+	assert.Len(t, candidates, 1<<(envname.MaxCandidateWords-1))
+	assert.Len(t, candidates[0], 1)
+	assert.Len(t, candidates[len(candidates)-1], envname.MaxCandidateWords)
+	seen := make(map[string]bool)
+	for i, path := range paths(candidates) {
+		key := strings.Join(path, ".")
+		assert.False(t, seen[key], key)
+		seen[key] = true
+		if i > 0 {
+			assert.LessOrEqual(t, len(candidates[i-1]), len(path))
+		}
+	}
+
+	assert.Nil(t, m.Candidates(name(envname.MaxCandidateWords+1)))
+	assert.Nil(t, m.Candidates(name(64)))
+	assert.Nil(t, m.Candidates(name(1000)))
+}
+
+func TestCandidatesRoundTrip(t *testing.T) {
+	m := envname.New([]rune("APP"))
+	path := [][]rune{[]rune("Database"), []rune("MaxConns")}
+	name := m.Name(path...)
+
+	found := false
+	for _, candidate := range m.Candidates(name) {
+		assert.Equal(t, string(name), string(m.Name(candidate...)))
+		if len(candidate) == 2 && string(candidate[0]) == "Database" && string(candidate[1]) == "MaxConns" {
+			found = true
+		}
+	}
+
+	assert.True(t, found)
+}
+
+func TestResolve(t *testing.T) {
+	m := envname.New([]rune("APP"))
+	known := [][][]rune{
+		{[]rune("Database"), []rune("MaxConns")},
+		{[]rune("Database"), []rune("Host")},
+		{[]rune("DatabaseMax"), []rune("Conns")},
+	}
+
+	assert.Equal(t, [][]string{{"Database", "Host"}}, paths(m.Resolve([]rune("APP_DATABASE_HOST"), known...)))
+	assert.Equal(t, [][]string{{"Database", "MaxConns"}, {"DatabaseMax", "Conns"}}, paths(m.Resolve([]rune("app_database_max_conns"), known...)))
+	assert.Empty(t, m.Resolve([]rune("APP_DATABASE_PORT"), known...))
+}
@@ -0,0 +1,181 @@
+package xrunes
+
+import (
+	"iter"
+	"slices"
+)
+
+// FoldMap is a map keyed by slices of runes that compares keys using Unicode
+// case-folding with the same semantics as EqualFold: two keys address the same
+// entry if and only if EqualFold reports them as equal. The spelling of the key
+// used when an entry was first set is preserved and returned during iteration.
+//
+// The zero value is an empty map ready to use. A FoldMap is not safe for
+// concurrent use. Iteration order is unspecified, as with built-in maps.
+type FoldMap[V any] struct {
+	m map[string]foldEntry[V]
+}
+
+type foldEntry[V any] struct {
+	key   []rune
+	value V
+}
+
+// NewFoldMap creates an empty FoldMap.
+//
+// Example:
+//
+//	m := NewFoldMap[int]()
+//	m.Set([]rune("Content-Type"), 1)
+//	v, ok := m.Get([]rune("content-type")) // v will be 1, ok will be true
+func NewFoldMap[V any]() *FoldMap[V] {
+	return &FoldMap[V]{}
+}
+
+// Len returns the number of entries in the map.
+func (m *FoldMap[V]) Len() int {
+	return len(m.m)
+}
+
+// Get returns the value stored for key and whether it was found.
+func (m *FoldMap[V]) Get(key []rune) (V, bool) {
+	e, ok := m.m[foldKey(key)]
+	return e.value, ok
+}
+
+// Has reports whether the map contains key.
+func (m *FoldMap[V]) Has(key []rune) bool {
+	_, ok := m.m[foldKey(key)]
+	return ok
+}
+
+// Key returns the spelling of the stored key that is equal to key under
+// EqualFold, and whether it was found.
+func (m *FoldMap[V]) Key(key []rune) ([]rune, bool) {
+	e, ok := m.m[foldKey(key)]
+	return e.key, ok
+}
+
+// Set stores value for key. When an entry equal to key under EqualFold already
+// exists, its value is replaced and its original spelling is kept.
+func (m *FoldMap[V]) Set(key []rune, value V) {
+	if m.m == nil {
+		m.m = make(map[string]foldEntry[V])
+	}
+
+	k := foldKey(key)
+	e, ok := m.m[k]
+	if !ok {
+		e.key = slices.Clone(key)
+	}
+
+	e.value = value
+	m.m[k] = e
+}
+
+// Delete removes the entry equal to key under EqualFold, if any.
+func (m *FoldMap[V]) Delete(key []rune) {
+	delete(m.m, foldKey(key))
+}
+
+// Clear removes every entry of the map.
+func (m *FoldMap[V]) Clear() {
+	clear(m.m)
+}
+
+// All returns an iterator over the entries of the map, yielding each key with
+// its original spelling.
+func (m *FoldMap[V]) All() iter.Seq2[[]rune, V] {
+	return func(yield func([]rune, V) bool) {
+		for _, e := range m.m {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of the map, with their original spelling.
+func (m *FoldMap[V]) Keys() iter.Seq[[]rune] {
+	return func(yield func([]rune) bool) {
+		for _, e := range m.m {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the map.
+func (m *FoldMap[V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, e := range m.m {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// FoldSet is a set of slices of runes that compares members using Unicode
+// case-folding with the same semantics as EqualFold. The spelling of each
+// member when it was first added is preserved.
+//
+// The zero value is an empty set ready to use. A FoldSet is not safe for
+// concurrent use.
+type FoldSet struct {
+	m FoldMap[struct{}]
+}
+
+// NewFoldSet creates a FoldSet containing the given members.
+func NewFoldSet(members ...[]rune) *FoldSet {
+	s := &FoldSet{}
+	for _, member := range members {
+		s.Add(member)
+	}
+
+	return s
+}
+
+// Len returns the number of members in the set.
+func (s *FoldSet) Len() int {
+	return s.m.Len()
+}
+
+// Add adds member to the set and reports whether it was not already present.
+func (s *FoldSet) Add(member []rune) bool {
+	if s.m.Has(member) {
+		return false
+	}
+
+	s.m.Set(member, struct{}{})
+	return true
+}
+
+// Has reports whether the set contains member.
+func (s *FoldSet) Has(member []rune) bool {
+	return s.m.Has(member)
+}
+
+// Delete removes member from the set, if present.
+func (s *FoldSet) Delete(member []rune) {
+	s.m.Delete(member)
+}
+
+// All returns an iterator over the members of the set, with their original spelling.
+func (s *FoldSet) All() iter.Seq[[]rune] {
+	return s.m.Keys()
+}
+
+// foldKey returns a string that is equal for two slices of runes if and only
+// if EqualFold reports them as equal. Each folded rune is written as 4 bytes
+// rather than as UTF-8, which would map every invalid rune to U+FFFD.
+func foldKey(s []rune) string {
+	key := make([]byte, 0, len(s)*4)
+	for _, r := range s {
+		f := uint32(foldRune(r))
+		key = append(key, byte(f>>24), byte(f>>16), byte(f>>8), byte(f))
+	}
+
+	return string(key)
+}
@@ -0,0 +1,100 @@
+package xrunes_test
+
+import (
+	"slices"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoldMap(t *testing.T) {
+	m := runes.NewFoldMap[int]()
+	m.Set([]rune("Content-Type"), 1)
+	m.Set([]rune("ACCEPT"), 2)
+	m.Set([]rune("content-type"), 3)
+	assert.Equal(t, 2, m.Len())
+
+	v, ok := m.Get([]rune("CONTENT-TYPE"))
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	key, ok := m.Key([]rune("content-TYPE"))
+	assert.True(t, ok)
+	assert.Equal(t, "Content-Type", string(key))
+
+	_, ok = m.Get([]rune("missing"))
+	assert.False(t, ok)
+	assert.True(t, m.Has([]rune("accept")))
+
+	all := make(map[string]int)
+	for k, v := range m.All() {
+		all[string(k)] = v
+	}
+
+	assert.Equal(t, map[string]int{"Content-Type": 3, "ACCEPT": 2}, all)
+	assert.ElementsMatch(t, []int{2, 3}, slices.Collect(m.Values()))
+
+	m.Delete([]rune("accept"))
+	assert.False(t, m.Has([]rune("ACCEPT")))
+	assert.Equal(t, 1, m.Len())
+
+	m.Clear()
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestFoldMapZeroValue(t *testing.T) {
+	var m runes.FoldMap[string]
+	_, ok := m.Get([]rune("a"))
+	assert.False(t, ok)
+	m.Delete([]rune("a"))
+
+	m.Set([]rune("K"), "kelvin")
+	v, ok := m.Get([]rune("k"))
+	assert.True(t, ok)
+	assert.Equal(t, "kelvin", v)
+}
+
+func TestFoldMapKeyIsCopied(t *testing.T) {
+	var m runes.FoldMap[int]
+	key := []rune("abc")
+	m.Set(key, 1)
+	key[0] = 'x'
+
+	stored, ok := m.Key([]rune("ABC"))
+	assert.True(t, ok)
+	assert.Equal(t, "abc", string(stored))
+}
+
+func TestFoldMapInvalidRunes(t *testing.T) {
+	keys := [][]rune{{0xD800}, {0xDFFF}, {0xFFFD}, {-1}, {0x110000}}
+	var m runes.FoldMap[int]
+	for i, key := range keys {
+		m.Set(key, i)
+	}
+
+	assert.Equal(t, len(keys), m.Len())
+	for i, key := range keys {
+		v, ok := m.Get(key)
+		assert.True(t, ok)
+		assert.Equal(t, i, v, "%U", key)
+	}
+}
+
+func TestFoldSet(t *testing.T) {
+	s := runes.NewFoldSet([]rune("Go"), []rune("Rust"))
+	assert.False(t, s.Add([]rune("GO")))
+	assert.True(t, s.Add([]rune("Zig")))
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has([]rune("rust")))
+
+	s.Delete([]rune("RUST"))
+	assert.False(t, s.Has([]rune("Rust")))
+
+	members := make([]string, 0)
+	for m := range s.All() {
+		members = append(members, string(m))
+	}
+
+	assert.ElementsMatch(t, []string{"Go", "Zig"}, members)
+}
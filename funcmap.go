@@ -0,0 +1,196 @@
+package xrunes
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/jolt9dev/go-xrunes/internal/inflection"
+	"github.com/jolt9dev/go-xrunes/internal/textwidth"
+)
+
+// tabWidth is the number of columns between tab stops when wrapping.
+const tabWidth = 8
+
+// FuncMap returns the template functions of xrunes. Its type is the
+// underlying type of the FuncMap of both text/template and html/template, so
+// it can be passed to either Funcs method; the tmpl package returns the same
+// map as a template.FuncMap. The functions take and return strings, and their
+// numeric arguments come first so that the string can be piped in:
+//
+//	snake          "userName"        -> "user_name"
+//	kebab          "userName"        -> "user-name"
+//	camel          "user_name"       -> "userName"
+//	pascal         "user_name"       -> "UserName"
+//	screamingSnake "maxConns"        -> "MAX_CONNS"
+//	title          "user_name"       -> "User Name"
+//	slug           "Crème Brûlée!"   -> "creme-brulee"
+//	truncate 8     "a long sentence" -> "a long …"
+//	pad 6          "id"              -> "id    "
+//	wrap 10        "a long sentence" -> "a long\nsentence"
+//	pluralize      "category"        -> "categories"
+//
+// truncate, pad and wrap count terminal columns, with wide East Asian
+// characters taking two, and pluralize uses the English rules of the inflect
+// package.
+//
+// wrap treats every line of its input as a paragraph. It keeps the leading
+// indentation of the line on each line it is broken into and the spacing
+// between words that stay on the same line, so text that already fits is
+// returned unchanged; only the spaces at a break are removed.
+//
+// Example:
+//
+//	t := template.Must(template.New("").Funcs(xrunes.FuncMap()).Parse(
+//		`type {{ pascal .Name }} struct{} // table {{ snake .Name | pluralize }}`))
+func FuncMap() map[string]any {
+	return map[string]any{
+		"snake":          func(s string) string { return string(Underscore([]rune(s))) },
+		"kebab":          func(s string) string { return string(Dasherize([]rune(s))) },
+		"camel":          func(s string) string { return string(CamelCase([]rune(s))) },
+		"pascal":         func(s string) string { return string(PascalCase([]rune(s))) },
+		"screamingSnake": func(s string) string { return string(Underscore([]rune(s), Screaming)) },
+		"title":          title,
+		"slug":           func(s string) string { return string(Slugify([]rune(s))) },
+		"truncate":       func(n int, s string) string { return string(truncateWidth([]rune(s), n)) },
+		"pad":            func(n int, s string) string { return string(padWidth([]rune(s), n)) },
+		"wrap":           func(n int, s string) string { return string(wrapWidth([]rune(s), n)) },
+		"pluralize":      inflection.Default().Pluralize,
+	}
+}
+
+// title capitalizes the words of s and joins them with spaces. The words of
+// a Train-Case conversion never contain '-', so it is safe to replace.
+func title(s string) string {
+	return strings.ReplaceAll(string(Convert([]rune(s), CaseTrain)), "-", " ")
+}
+
+// truncateWidth shortens s to at most n columns, replacing the end with '…'
+// when runes are removed.
+func truncateWidth(s []rune, n int) []rune {
+	if textwidth.Runes(s) <= n {
+		return s
+	}
+
+	if n <= 0 {
+		return []rune{}
+	}
+
+	w := 0
+	for i, r := range s {
+		w += textwidth.Rune(r)
+		if w > n-1 {
+			return append(append([]rune{}, s[:i]...), '…')
+		}
+	}
+
+	return s
+}
+
+// padWidth appends spaces to s until it is n columns wide.
+func padWidth(s []rune, n int) []rune {
+	w := textwidth.Runes(s)
+	if w >= n {
+		return s
+	}
+
+	sb := make([]rune, 0, len(s)+n-w)
+	sb = append(sb, s...)
+	for ; w < n; w++ {
+		sb = append(sb, ' ')
+	}
+
+	return sb
+}
+
+// wrapWidth breaks every line of s between words so that it is at most n
+// columns wide, repeating its leading indentation on the lines it is broken
+// into. Words longer than the space left after the indentation are kept whole
+// on their own line.
+func wrapWidth(s []rune, n int) []rune {
+	sb := make([]rune, 0, len(s))
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && s[i] != '\n' {
+			continue
+		}
+
+		sb = wrapLine(sb, s[start:i], n)
+		if i < len(s) {
+			sb = append(sb, '\n')
+		}
+
+		start = i + 1
+	}
+
+	return sb
+}
+
+// wrapLine appends line to sb, wrapped at n columns.
+func wrapLine(sb []rune, line []rune, n int) []rune {
+	i := 0
+	for i < len(line) && isBlank(line[i]) {
+		i++
+	}
+
+	indent := line[:i]
+	sb = append(sb, indent...)
+	indentWidth := advance(0, indent)
+	w := indentWidth
+	first := true
+	for i < len(line) {
+		gapStart := i
+		for i < len(line) && isBlank(line[i]) {
+			i++
+		}
+
+		gap := line[gapStart:i]
+		wordStart := i
+		for i < len(line) && !isBlank(line[i]) {
+			i++
+		}
+
+		word := line[wordStart:i]
+		if len(word) == 0 {
+			// trailing blanks are kept when they fit.
+			if advance(w, gap) <= n {
+				sb = append(sb, gap...)
+			}
+
+			break
+		}
+
+		if !first && advance(advance(w, gap), word) > n {
+			sb = append(sb, '\n')
+			sb = append(sb, indent...)
+			w = indentWidth
+		} else {
+			sb = append(sb, gap...)
+			w = advance(w, gap)
+		}
+
+		sb = append(sb, word...)
+		w = advance(w, word)
+		first = false
+	}
+
+	return sb
+}
+
+// advance returns the column reached by writing s from column w, with tabs
+// moving to the next tab stop.
+func advance(w int, s []rune) int {
+	for _, r := range s {
+		if r == '\t' {
+			w += tabWidth - w%tabWidth
+		} else {
+			w += textwidth.Rune(r)
+		}
+	}
+
+	return w
+}
+
+// isBlank reports whether r separates words on a line.
+func isBlank(r rune) bool {
+	return r != '\n' && unicode.IsSpace(r)
+}
@@ -0,0 +1,32 @@
+package xrunes_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuncMap(t *testing.T) {
+	tpl, err := template.New("test").Funcs(runes.FuncMap()).Parse(
+		`{{ pascal .Name }} {{ snake .Name | pluralize }} [{{ "id" | pad 4 }}] {{ "a long sentence" | wrap 8 }}`)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, tpl.Execute(&sb, map[string]string{"Name": "order category"}))
+	assert.Equal(t, "OrderCategory order_categories [id  ] a long\nsentence", sb.String())
+}
+
+func TestFuncMapHTML(t *testing.T) {
+	tpl, err := htmltemplate.New("test").Funcs(runes.FuncMap()).Parse(
+		`<a href="/{{ slug .Title }}">{{ title .Title }}</a>`)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, tpl.Execute(&sb, map[string]string{"Title": "Fish & Chips"}))
+	assert.Equal(t, `<a href="/fish-chips">Fish Chips</a>`, sb.String())
+}
@@ -0,0 +1,231 @@
+package xrunes
+
+import (
+	"slices"
+	"unicode"
+)
+
+const (
+	fuzzyScoreMatch        = 16
+	fuzzyScoreGapStart     = -3
+	fuzzyScoreGapExtension = -1
+	fuzzyBonusFirst        = 10
+	fuzzyBonusBoundary     = 8
+	fuzzyBonusCamel        = 7
+	fuzzyBonusConsecutive  = 4
+)
+
+// FuzzyParams defines the parameters used by FuzzyMatch and FuzzyRank.
+type FuzzyParams struct {
+	// CaseSensitive disables Unicode case-folding when comparing runes.
+	// By default query runes match candidate runes the same way EqualFold does.
+	CaseSensitive bool
+}
+
+// FuzzyOption is a function type that modifies the options for FuzzyParams.
+type FuzzyOption func(params *FuzzyParams)
+
+// FuzzyCaseSensitive sets the CaseSensitive field of the given FuzzyParams to true.
+func FuzzyCaseSensitive(params *FuzzyParams) {
+	params.CaseSensitive = true
+}
+
+// FuzzyResult is a single ranked candidate returned by FuzzyRank.
+type FuzzyResult struct {
+	// Index is the position of the candidate in the slice passed to FuzzyRank.
+	Index int
+	// Candidate is the candidate that was matched.
+	Candidate []rune
+	// Score is the match score; higher is better.
+	Score int
+	// Positions are the indexes of the candidate runes matched by the query.
+	Positions []int
+}
+
+// FuzzyMatch reports whether the runes of query appear in candidate in order,
+// not necessarily contiguously, and scores the best such alignment. Matches on
+// word boundaries (after '_', '-', spaces and other separators, or on camel
+// humps) and runs of consecutive matches score higher, while gaps between
+// matched runes are penalized, similar to the scoring used by fzf.
+//
+// It returns the score, the index in candidate of each matched query rune and
+// whether the query matched at all. An empty query matches every candidate with
+// a score of zero.
+//
+// Example:
+//
+//	score, positions, ok := FuzzyMatch([]rune("gco"), []rune("git-checkout"))
+//	// ok will be true and positions will be [0 4 9]
+func FuzzyMatch(query []rune, candidate []rune, options ...FuzzyOption) (score int, positions []int, ok bool) {
+	params := &FuzzyParams{}
+	for _, option := range options {
+		option(params)
+	}
+
+	return fuzzyMatch(query, candidate, params)
+}
+
+// FuzzyRank matches query against every candidate and returns the ones that
+// matched, ordered by descending score. Ties are broken by the shorter
+// candidate first and then by their original order.
+func FuzzyRank(query []rune, candidates [][]rune, options ...FuzzyOption) []FuzzyResult {
+	params := &FuzzyParams{}
+	for _, option := range options {
+		option(params)
+	}
+
+	results := make([]FuzzyResult, 0)
+	for i, candidate := range candidates {
+		score, positions, ok := fuzzyMatch(query, candidate, params)
+		if !ok {
+			continue
+		}
+
+		results = append(results, FuzzyResult{
+			Index:     i,
+			Candidate: candidate,
+			Score:     score,
+			Positions: positions,
+		})
+	}
+
+	slices.SortStableFunc(results, func(x, y FuzzyResult) int {
+		if x.Score != y.Score {
+			return y.Score - x.Score
+		}
+
+		return len(x.Candidate) - len(y.Candidate)
+	})
+
+	return results
+}
+
+func fuzzyMatch(query, candidate []rune, params *FuzzyParams) (int, []int, bool) {
+	n := len(query)
+	m := len(candidate)
+	if n == 0 {
+		return 0, []int{}, true
+	}
+
+	if m < n {
+		return 0, nil, false
+	}
+
+	q := query
+	c := candidate
+	if !params.CaseSensitive {
+		q = make([]rune, n)
+		for i, r := range query {
+			q[i] = foldRune(r)
+		}
+
+		c = make([]rune, m)
+		for i, r := range candidate {
+			c[i] = foldRune(r)
+		}
+	}
+
+	// quick rejection when query is not a subsequence of candidate.
+	j := 0
+	for i := 0; i < m && j < n; i++ {
+		if c[i] == q[j] {
+			j++
+		}
+	}
+
+	if j < n {
+		return 0, nil, false
+	}
+
+	bonus := make([]int, m)
+	for i := range candidate {
+		switch {
+		case !isWordStart(candidate, i):
+		case i == 0:
+			bonus[i] = fuzzyBonusFirst
+		case unicode.IsUpper(candidate[i]) && unicode.IsLower(candidate[i-1]):
+			bonus[i] = fuzzyBonusCamel
+		default:
+			bonus[i] = fuzzyBonusBoundary
+		}
+	}
+
+	// score[i*m+j] is the best score of matching q[:i+1] with q[i] at c[j],
+	// and from[i*m+j] the candidate index used for q[i-1] on that path.
+	const none = -1 << 30
+	score := make([]int, n*m)
+	from := make([]int, n*m)
+	for i := range score {
+		score[i] = none
+	}
+
+	for j := 0; j < m; j++ {
+		if c[j] == q[0] {
+			score[j] = fuzzyScoreMatch + bonus[j]
+		}
+	}
+
+	for i := 1; i < n; i++ {
+		row := i * m
+		prev := row - m
+		gap := none
+		gapFrom := -1
+		for j := i; j < m; j++ {
+			// extend the best gap that ends right before j.
+			if j >= 2 {
+				if gap != none {
+					gap += fuzzyScoreGapExtension
+				}
+
+				if s := score[prev+j-2]; s != none && s+fuzzyScoreGapStart > gap {
+					gap = s + fuzzyScoreGapStart
+					gapFrom = j - 2
+				}
+			}
+
+			if c[j] != q[i] {
+				continue
+			}
+
+			best := none
+			bestFrom := -1
+			if s := score[prev+j-1]; s != none {
+				best = s + fuzzyBonusConsecutive
+				bestFrom = j - 1
+			}
+
+			if gap != none && gap > best {
+				best = gap
+				bestFrom = gapFrom
+			}
+
+			if best == none {
+				continue
+			}
+
+			score[row+j] = best + fuzzyScoreMatch + bonus[j]
+			from[row+j] = bestFrom
+		}
+	}
+
+	last := (n - 1) * m
+	end := -1
+	for j := n - 1; j < m; j++ {
+		if score[last+j] != none && (end < 0 || score[last+j] > score[last+end]) {
+			end = j
+		}
+	}
+
+	if end < 0 {
+		return 0, nil, false
+	}
+
+	positions := make([]int, n)
+	j = end
+	for i := n - 1; i >= 0; i-- {
+		positions[i] = j
+		j = from[i*m+j]
+	}
+
+	return score[last+end], positions, true
+}
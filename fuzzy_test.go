@@ -0,0 +1,72 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	score, positions, ok := runes.FuzzyMatch([]rune("gco"), []rune("git-checkout"))
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 4, 9}, positions)
+	assert.Greater(t, score, 0)
+
+	_, positions, ok = runes.FuzzyMatch([]rune("uid"), []rune("getUserId"))
+	assert.True(t, ok)
+	assert.Equal(t, []int{3, 7, 8}, positions)
+
+	_, positions, ok = runes.FuzzyMatch([]rune("fb"), []rune("foo_bar"))
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 4}, positions)
+
+	_, _, ok = runes.FuzzyMatch([]rune("xyz"), []rune("git-checkout"))
+	assert.False(t, ok)
+
+	_, _, ok = runes.FuzzyMatch([]rune("oc"), []rune("co"))
+	assert.False(t, ok)
+
+	score, positions, ok = runes.FuzzyMatch([]rune(""), []rune("anything"))
+	assert.True(t, ok)
+	assert.Equal(t, 0, score)
+	assert.Empty(t, positions)
+}
+
+func TestFuzzyMatchFold(t *testing.T) {
+	_, positions, ok := runes.FuzzyMatch([]rune("GC"), []rune("git-checkout"))
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 4}, positions)
+
+	_, _, ok = runes.FuzzyMatch([]rune("GC"), []rune("git-checkout"), runes.FuzzyCaseSensitive)
+	assert.False(t, ok)
+}
+
+func TestFuzzyMatchPrefersBoundaries(t *testing.T) {
+	boundary, _, _ := runes.FuzzyMatch([]rune("ab"), []rune("alpha-beta"))
+	inner, _, _ := runes.FuzzyMatch([]rune("ab"), []rune("xaxxb"))
+	consecutive, _, _ := runes.FuzzyMatch([]rune("ab"), []rune("xabx"))
+	assert.Greater(t, boundary, consecutive)
+	assert.Greater(t, consecutive, inner)
+}
+
+func TestFuzzyRank(t *testing.T) {
+	candidates := [][]rune{
+		[]rune("remote-add"),
+		[]rune("rebase"),
+		[]rune("reset"),
+		[]rune("status"),
+		[]rune("restore"),
+	}
+
+	results := runes.FuzzyRank([]rune("rs"), candidates)
+	names := make([]string, len(results))
+	for i, r := range results {
+		names[i] = string(r.Candidate)
+	}
+
+	assert.Equal(t, []string{"reset", "restore", "rebase"}, names)
+	assert.Equal(t, 2, results[0].Index)
+	assert.Equal(t, []int{0, 2}, results[0].Positions)
+	assert.Empty(t, runes.FuzzyRank([]rune("zz"), candidates))
+}
@@ -0,0 +1,205 @@
+//go:build ignore
+
+// gen generates tables.go from the Unicode Character Database.
+//
+// Usage:
+//
+//	curl -O https://www.unicode.org/Public/14.0.0/ucd/UnicodeData.txt
+//	go run gen.go -ucd UnicodeData.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type char struct {
+	category string
+	decomp   []rune
+}
+
+// translit holds the transliterations that cannot be derived from the
+// decompositions in the UCD: Latin letters without a decomposition, and the
+// Greek (ELOT 743) and Cyrillic (BGN/PCGN) alphabets. They are checked before
+// decomposing, so they also override the mapping of letters such as й.
+var translit = map[rune]string{
+	// Latin
+	'Æ': "AE", 'æ': "ae", 'Ø': "O", 'ø': "o", 'Đ': "D", 'đ': "d", 'Ð': "D", 'ð': "d",
+	'Ł': "L", 'ł': "l", 'Þ': "Th", 'þ': "th", 'ß': "ss", 'ẞ': "SS", 'Œ': "OE", 'œ': "oe",
+	'ı': "i", 'Ŋ': "NG", 'ŋ': "ng", 'Ħ': "H", 'ħ': "h", 'Ŧ': "T", 'ŧ': "t", 'ĸ': "q",
+	'Ƒ': "F", 'ƒ': "f", 'Ɖ': "D", 'ɖ': "d", 'Ɛ': "E", 'ɛ': "e", 'ƀ': "b", 'Ɨ': "I", 'ɨ': "i",
+
+	// Greek
+	'Α': "A", 'Β': "V", 'Γ': "G", 'Δ': "D", 'Ε': "E", 'Ζ': "Z", 'Η': "I", 'Θ': "Th",
+	'Ι': "I", 'Κ': "K", 'Λ': "L", 'Μ': "M", 'Ν': "N", 'Ξ': "X", 'Ο': "O", 'Π': "P",
+	'Ρ': "R", 'Σ': "S", 'Τ': "T", 'Υ': "Y", 'Φ': "F", 'Χ': "Ch", 'Ψ': "Ps", 'Ω': "O",
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps",
+	'ω': "o",
+
+	// Cyrillic
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ё': "Yo", 'Ж': "Zh",
+	'З': "Z", 'И': "I", 'Й': "Y", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O",
+	'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U", 'Ф': "F", 'Х': "Kh", 'Ц': "Ts",
+	'Ч': "Ch", 'Ш': "Sh", 'Щ': "Shch", 'Ъ': "", 'Ы': "Y", 'Ь': "", 'Э': "E", 'Ю': "Yu",
+	'Я': "Ya", 'Є': "Ye", 'І': "I", 'Ї': "Yi", 'Ґ': "G", 'Ў': "U", 'Ђ': "Dj", 'Ј': "J",
+	'Љ': "Lj", 'Њ': "Nj", 'Ћ': "C", 'Џ': "Dz",
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya", 'є': "ye", 'і': "i", 'ї': "yi", 'ґ': "g", 'ў': "u", 'ђ': "dj", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'џ': "dz",
+}
+
+func main() {
+	ucd := flag.String("ucd", "UnicodeData.txt", "path to the UCD UnicodeData.txt file")
+	output := flag.String("output", "tables.go", "path of the generated file")
+	flag.Parse()
+
+	chars := readUnicodeData(*ucd)
+
+	runes := make([]rune, 0, len(chars))
+	for r := range chars {
+		runes = append(runes, r)
+	}
+
+	slices.Sort(runes)
+
+	keys := make([]string, 0)
+	values := make([]string, 0)
+	for _, r := range runes {
+		if r < 0x80 {
+			continue
+		}
+
+		s, ok := transliterate(chars, r)
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, fmt.Sprintf("0x%X", r))
+		values = append(values, strconv.Quote(s))
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from UnicodeData.txt; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package xrunes\n\n")
+	writeList(&buf, "translitRunes", "rune", keys, 8)
+	writeList(&buf, "translitValues", "string", values, 8)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// transliterate returns the ASCII transliteration of r, derived from its full
+// canonical and compatibility decomposition with the combining marks removed.
+func transliterate(chars map[rune]char, r rune) (string, bool) {
+	if s, ok := translit[r]; ok {
+		return s, true
+	}
+
+	c, ok := chars[r]
+	if !ok || strings.HasPrefix(c.category, "M") || len(c.decomp) == 0 {
+		return "", false
+	}
+
+	var sb strings.Builder
+	for _, d := range c.decomp {
+		if d < 0x80 {
+			sb.WriteRune(d)
+			continue
+		}
+
+		if strings.HasPrefix(chars[d].category, "M") {
+			continue
+		}
+
+		s, ok := transliterate(chars, d)
+		if !ok {
+			return "", false
+		}
+
+		sb.WriteString(s)
+	}
+
+	if sb.Len() == 0 {
+		return "", false
+	}
+
+	return sb.String(), true
+}
+
+func readUnicodeData(path string) map[rune]char {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	chars := make(map[rune]char)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) < 6 {
+			continue
+		}
+
+		r := rune(parseHex(fields[0]))
+		c := char{category: fields[2]}
+		for _, d := range strings.Fields(fields[5]) {
+			if strings.HasPrefix(d, "<") {
+				continue
+			}
+
+			c.decomp = append(c.decomp, rune(parseHex(d)))
+		}
+
+		chars[r] = c
+	}
+
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	return chars
+}
+
+func writeList(buf *bytes.Buffer, name, typ string, values []string, perLine int) {
+	fmt.Fprintf(buf, "var %s = [...]%s{", name, typ)
+	for i, v := range values {
+		if i%perLine == 0 {
+			buf.WriteString("\n\t")
+		} else {
+			buf.WriteByte(' ')
+		}
+
+		buf.WriteString(v)
+		buf.WriteByte(',')
+	}
+	fmt.Fprintf(buf, "\n}\n\n")
+}
+
+func parseHex(s string) uint64 {
+	v, err := strconv.ParseUint(strings.TrimSpace(s), 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return v
+}
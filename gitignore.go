@@ -0,0 +1,198 @@
+package xrunes
+
+import (
+	"fmt"
+	"slices"
+)
+
+// IgnoreSet is an ordered set of gitignore patterns. Paths are given as runes
+// relative to the root of the set, using '/' as the separator.
+//
+// The patterns follow the gitignore semantics:
+//   - blank lines and lines starting with '#' are ignored;
+//   - a leading '!' negates the pattern, re-including a previously excluded path;
+//   - a trailing '/' only matches directories;
+//   - a pattern containing a '/' other than a trailing one is anchored to the
+//     directory of the file it was read from, otherwise it matches at any depth;
+//   - '*', '?', '[class]' and '**' behave as in Glob, while braces are literal;
+//   - the last matching pattern wins, and a path inside an excluded directory
+//     cannot be re-included.
+type IgnoreSet struct {
+	patterns []ignorePattern
+}
+
+type ignorePattern struct {
+	base    []rune
+	negate  bool
+	dirOnly bool
+	glob    *Glob
+}
+
+// NewIgnoreSet creates an empty IgnoreSet.
+func NewIgnoreSet() *IgnoreSet {
+	return &IgnoreSet{}
+}
+
+// ParseIgnore creates an IgnoreSet from the contents of a .gitignore file
+// located at the root of the set.
+//
+// Example:
+//
+//	set, _ := ParseIgnore([]rune("*.log\n!keep.log\nbuild/\n"))
+//	set.Match([]rune("logs/debug.log"), false) // true
+//	set.Match([]rune("keep.log"), false)       // false
+func ParseIgnore(src []rune) (*IgnoreSet, error) {
+	s := NewIgnoreSet()
+	if err := s.Parse(nil, src); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Parse adds the patterns of a .gitignore file located in the directory base,
+// relative to the root of the set. Use an empty base for the root directory.
+// Patterns added later take precedence over the ones added before, so nested
+// files should be parsed after their parents. When a line is malformed, no
+// pattern of src is added and the set is left unchanged.
+func (s *IgnoreSet) Parse(base []rune, src []rune) error {
+	parsed := NewIgnoreSet()
+	n := 1
+	start := 0
+	for i := 0; i <= len(src); i++ {
+		if i < len(src) && src[i] != '\n' {
+			continue
+		}
+
+		line := src[start:i]
+		if len(line) > 0 && line[len(line)-1] == '\r' {
+			line = line[:len(line)-1]
+		}
+
+		if err := parsed.Add(base, line); err != nil {
+			return fmt.Errorf("xrunes: gitignore line %d: %w", n, err)
+		}
+
+		start = i + 1
+		n++
+	}
+
+	s.patterns = append(s.patterns, parsed.patterns...)
+	return nil
+}
+
+// Add adds a single gitignore pattern read from a .gitignore file located in
+// the directory base. Blank lines and comments are accepted and ignored.
+func (s *IgnoreSet) Add(base []rune, line []rune) error {
+	line = trimIgnoreSpace(line)
+	if len(line) == 0 || line[0] == '#' {
+		return nil
+	}
+
+	p := ignorePattern{base: TrimRight(base, []rune("/"))}
+	if line[0] == '!' {
+		p.negate = true
+		line = line[1:]
+	}
+
+	if len(line) > 0 && line[len(line)-1] == '/' {
+		p.dirOnly = true
+		line = line[:len(line)-1]
+	}
+
+	if len(line) == 0 {
+		return nil
+	}
+
+	anchored := IndexRune(line, '/') > -1
+	if line[0] == '/' {
+		line = line[1:]
+	}
+
+	pattern := make([]rune, 0, len(line)+3)
+	if !anchored && !HasPrefix(line, []rune("**")) {
+		pattern = append(pattern, '*', '*', '/')
+	}
+
+	// braces have no special meaning in gitignore. Escape sequences are
+	// copied as they are, since the glob syntax shares them.
+	for i := 0; i < len(line); i++ {
+		r := line[i]
+		switch {
+		case r == '\\' && i+1 < len(line):
+			i++
+			pattern = append(pattern, r, line[i])
+			continue
+		case r == '{' || r == '}' || r == ',':
+			pattern = append(pattern, '\\')
+		}
+
+		pattern = append(pattern, r)
+	}
+
+	g, err := CompileGlob(pattern)
+	if err != nil {
+		return err
+	}
+
+	p.glob = g
+	s.patterns = append(s.patterns, p)
+
+	return nil
+}
+
+// Len returns the number of patterns in the set.
+func (s *IgnoreSet) Len() int {
+	return len(s.patterns)
+}
+
+// Match reports whether path is ignored by the set. isDir tells whether path
+// names a directory, which is required by patterns ending with '/'. A path is
+// also ignored when one of its parent directories is.
+func (s *IgnoreSet) Match(path []rune, isDir bool) bool {
+	path = Trim(path, []rune("/"))
+	for i, r := range path {
+		if r == '/' && s.match(path[:i], true) {
+			return true
+		}
+	}
+
+	return s.match(path, isDir)
+}
+
+func (s *IgnoreSet) match(path []rune, isDir bool) bool {
+	for _, p := range slices.Backward(s.patterns) {
+		if p.dirOnly && !isDir {
+			continue
+		}
+
+		rel := path
+		if len(p.base) > 0 {
+			if !HasPrefix(path, p.base) || len(path) <= len(p.base) || path[len(p.base)] != '/' {
+				continue
+			}
+
+			rel = path[len(p.base)+1:]
+		}
+
+		if p.glob.Match(rel) {
+			return !p.negate
+		}
+	}
+
+	return false
+}
+
+// trimIgnoreSpace removes trailing spaces that are not escaped with a backslash.
+func trimIgnoreSpace(line []rune) []rune {
+	end := len(line)
+	for end > 0 && line[end-1] == ' ' {
+		if end > 1 && line[end-2] == '\\' {
+			break
+		}
+
+		end--
+	}
+
+	return line[:end]
+}
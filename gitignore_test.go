@@ -0,0 +1,162 @@
+package xrunes_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIgnoreSet(t *testing.T) {
+	set, err := runes.ParseIgnore([]rune("# comment\n\n*.log\n!keep.log\nbuild/\n/root.txt\ndocs/**/*.tmp\n\\#hash\ntrailing\\ \n{a,b}\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 8, set.Len())
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"debug.log", false, true},
+		{"logs/debug.log", false, true},
+		{"keep.log", false, false},
+		{"logs/keep.log", false, false},
+		{"build", true, true},
+		{"build", false, false},
+		{"src/build", true, true},
+		{"build/out.bin", false, true},
+		{"root.txt", false, true},
+		{"sub/root.txt", false, false},
+		{"docs/a.tmp", false, true},
+		{"docs/x/y/a.tmp", false, true},
+		{"a.tmp", false, false},
+		{"#hash", false, true},
+		{"trailing ", false, true},
+		{"trailing", false, false},
+		{"{a,b}", false, true},
+		{"a", false, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, set.Match([]rune(tt.path), tt.isDir), tt.path)
+	}
+}
+
+func TestIgnoreSetEscapes(t *testing.T) {
+	set, err := runes.ParseIgnore([]rune("\\{x\\}\n\\[y]\nz\\*\n"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{"{x}", true},
+		{"\\{x}", false},
+		{"x", false},
+		{"[y]", true},
+		{"y", false},
+		{"z*", true},
+		{"zz", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, set.Match([]rune(tt.path), false), tt.path)
+	}
+}
+
+func TestIgnoreSetParentExcluded(t *testing.T) {
+	set, err := runes.ParseIgnore([]rune("vendor/\n!vendor/keep.go\n"))
+	require.NoError(t, err)
+	assert.True(t, set.Match([]rune("vendor/keep.go"), false))
+
+	set, err = runes.ParseIgnore([]rune("vendor/*\n!vendor/keep.go\n"))
+	require.NoError(t, err)
+	assert.False(t, set.Match([]rune("vendor/keep.go"), false))
+	assert.True(t, set.Match([]rune("vendor/drop.go"), false))
+}
+
+func TestIgnoreSetBadPattern(t *testing.T) {
+	_, err := runes.ParseIgnore([]rune("ok\n[abc\n"))
+	assert.ErrorIs(t, err, runes.ErrBadPattern)
+	assert.Contains(t, err.Error(), "line 2")
+
+	set, err := runes.ParseIgnore([]rune("*.log\n"))
+	require.NoError(t, err)
+	assert.ErrorIs(t, set.Parse(nil, []rune("*.tmp\n[abc\n")), runes.ErrBadPattern)
+	assert.Equal(t, 1, set.Len())
+	assert.False(t, set.Match([]rune("a.tmp"), false))
+}
+
+// TestIgnoreSetFixture builds a directory tree with nested .gitignore files
+// and checks which paths are kept when walking it.
+func TestIgnoreSetFixture(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".gitignore":            "*.log\n/dist/\nnode_modules/\n",
+		"main.go":               "",
+		"app.log":               "",
+		"dist/bundle.js":        "",
+		"src/dist/keep.js":      "",
+		"src/app.go":            "",
+		"src/.gitignore":        "*.gen.go\n!keep.gen.go\n/local/\n",
+		"src/types.gen.go":      "",
+		"src/keep.gen.go":       "",
+		"src/local/cache.txt":   "",
+		"local/cache.txt":       "",
+		"web/node_modules/x.js": "",
+		"web/index.js":          "",
+		"web/debug.log":         "",
+	}
+
+	for name, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+
+	set := runes.NewIgnoreSet()
+	kept := make([]string, 0)
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			rel = ""
+		} else if set.Match([]rune(rel), d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			data, err := os.ReadFile(filepath.Join(p, ".gitignore"))
+			if err == nil {
+				return set.Parse([]rune(rel), []rune(string(data)))
+			}
+
+			return nil
+		}
+
+		kept = append(kept, rel)
+		return nil
+	})
+	require.NoError(t, err)
+
+	slices.Sort(kept)
+	assert.Equal(t, strings.Fields(".gitignore local/cache.txt main.go src/.gitignore src/app.go src/dist/keep.js src/keep.gen.go web/index.js"), kept)
+}
@@ -0,0 +1,430 @@
+package xrunes
+
+import (
+	"errors"
+	"unicode"
+)
+
+// ErrBadPattern indicates a glob pattern was malformed.
+var ErrBadPattern = errors.New("xrunes: syntax error in pattern")
+
+// GlobParams defines the parameters used when compiling a glob pattern.
+type GlobParams struct {
+	// Fold compares runes using Unicode case-folding, the same way EqualFold does.
+	Fold bool
+}
+
+// GlobOption is a function type that modifies the options for GlobParams.
+type GlobOption func(params *GlobParams)
+
+// GlobFold sets the Fold field of the given GlobParams to true.
+func GlobFold(params *GlobParams) {
+	params.Fold = true
+}
+
+// Glob is a compiled glob pattern that can be matched against many names.
+//
+// The pattern syntax is:
+//
+//	pattern  meaning
+//	*        matches any sequence of runes except '/'
+//	**       matches any sequence of runes, including '/'
+//	**/      as a whole path segment, matches zero or more directories
+//	?        matches any single rune except '/'
+//	[class]  matches one rune in class, e.g. [abc], [a-z] or [!0-9] (also [^0-9])
+//	{a,b}    matches any of the comma separated alternatives, which may be nested;
+//	         the braces of a group without a comma, such as {a}, match literally
+//	\c       matches the rune c literally
+//
+// A Glob is immutable and safe for concurrent use.
+type Glob struct {
+	pattern      []rune
+	params       GlobParams
+	alternatives [][]globToken
+}
+
+type globKind int
+
+const (
+	globLiteral globKind = iota
+	globAny
+	globStar
+	globStarStar
+	globDirs
+	globClass
+)
+
+type globRange struct {
+	lo rune
+	hi rune
+}
+
+type globToken struct {
+	kind   globKind
+	r      rune
+	ranges []globRange
+	negate bool
+}
+
+// CompileGlob parses pattern into a Glob. It returns ErrBadPattern when the
+// pattern contains an unterminated class, brace or escape.
+func CompileGlob(pattern []rune, options ...GlobOption) (*Glob, error) {
+	g := &Glob{pattern: pattern}
+	for _, option := range options {
+		option(&g.params)
+	}
+
+	expanded, err := expandBraces(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alt := range expanded {
+		tokens, err := parseGlob(alt)
+		if err != nil {
+			return nil, err
+		}
+
+		g.alternatives = append(g.alternatives, tokens)
+	}
+
+	return g, nil
+}
+
+// MustCompileGlob is like CompileGlob but panics if the pattern is malformed.
+func MustCompileGlob(pattern []rune, options ...GlobOption) *Glob {
+	g, err := CompileGlob(pattern, options...)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+// Match reports whether name matches the glob pattern. The pattern is compiled
+// on every call; use CompileGlob to match the same pattern repeatedly.
+//
+// Example:
+//
+//	ok, _ := Match([]rune("*.{yml,yaml}"), []rune("config.yaml")) // ok will be true
+func Match(pattern []rune, name []rune, options ...GlobOption) (bool, error) {
+	g, err := CompileGlob(pattern, options...)
+	if err != nil {
+		return false, err
+	}
+
+	return g.Match(name), nil
+}
+
+// Pattern returns the pattern the Glob was compiled from.
+func (g *Glob) Pattern() []rune {
+	return g.pattern
+}
+
+// Match reports whether name matches the compiled pattern.
+func (g *Glob) Match(name []rune) bool {
+	cur := make([]bool, len(name)+1)
+	next := make([]bool, len(name)+1)
+	for _, tokens := range g.alternatives {
+		if g.matchTokens(tokens, name, cur, next) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchTokens runs the tokens over name keeping the set of reachable
+// positions in name, which avoids the exponential backtracking of naive
+// wildcard matching.
+func (g *Glob) matchTokens(tokens []globToken, name []rune, cur, next []bool) bool {
+	n := len(name)
+	clear(cur)
+	cur[0] = true
+	for _, tok := range tokens {
+		clear(next)
+		alive := false
+		switch tok.kind {
+		case globStar:
+			for k := 0; k <= n; k++ {
+				next[k] = cur[k] || (k > 0 && next[k-1] && name[k-1] != '/')
+				alive = alive || next[k]
+			}
+		case globStarStar:
+			for k := 0; k <= n; k++ {
+				next[k] = cur[k] || (k > 0 && next[k-1])
+				alive = alive || next[k]
+			}
+		case globDirs:
+			seen := false
+			for k := 0; k <= n; k++ {
+				next[k] = cur[k] || (seen && name[k-1] == '/')
+				seen = seen || cur[k]
+				alive = alive || next[k]
+			}
+		default:
+			for k := 0; k < n; k++ {
+				if cur[k] && g.matchRune(tok, name[k]) {
+					next[k+1] = true
+					alive = true
+				}
+			}
+		}
+
+		if !alive {
+			return false
+		}
+
+		cur, next = next, cur
+	}
+
+	return cur[n]
+}
+
+func (g *Glob) matchRune(tok globToken, r rune) bool {
+	switch tok.kind {
+	case globAny:
+		return r != '/'
+	case globLiteral:
+		if g.params.Fold {
+			return equalRuneFold(tok.r, r)
+		}
+
+		return tok.r == r
+	case globClass:
+		if r == '/' {
+			return false
+		}
+
+		found := inGlobRanges(tok.ranges, r)
+		if !found && g.params.Fold && unicode.IsLetter(r) {
+			for f := unicode.SimpleFold(r); f != r && !found; f = unicode.SimpleFold(f) {
+				found = inGlobRanges(tok.ranges, f)
+			}
+		}
+
+		return found != tok.negate
+	}
+
+	return false
+}
+
+func inGlobRanges(ranges []globRange, r rune) bool {
+	for _, rg := range ranges {
+		if r >= rg.lo && r <= rg.hi {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseGlob converts a pattern without braces into tokens.
+func parseGlob(p []rune) ([]globToken, error) {
+	tokens := make([]globToken, 0, len(p))
+	for i := 0; i < len(p); i++ {
+		switch r := p[i]; r {
+		case '\\':
+			if i+1 == len(p) {
+				return nil, ErrBadPattern
+			}
+
+			i++
+			tokens = append(tokens, globToken{kind: globLiteral, r: p[i]})
+		case '?':
+			tokens = append(tokens, globToken{kind: globAny})
+		case '*':
+			if i+1 < len(p) && p[i+1] == '*' {
+				start := i
+				for i+1 < len(p) && p[i+1] == '*' {
+					i++
+				}
+
+				atStart := start == 0 || p[start-1] == '/'
+				if atStart && i+1 < len(p) && p[i+1] == '/' {
+					i++
+					tokens = append(tokens, globToken{kind: globDirs})
+					continue
+				}
+
+				tokens = append(tokens, globToken{kind: globStarStar})
+				continue
+			}
+
+			tokens = append(tokens, globToken{kind: globStar})
+		case '[':
+			tok, n, err := parseGlobClass(p[i:])
+			if err != nil {
+				return nil, err
+			}
+
+			tokens = append(tokens, tok)
+			i += n - 1
+		default:
+			tokens = append(tokens, globToken{kind: globLiteral, r: r})
+		}
+	}
+
+	return tokens, nil
+}
+
+// parseGlobClass parses a character class starting at p[0] == '[' and returns
+// the token and the number of runes consumed.
+func parseGlobClass(p []rune) (globToken, int, error) {
+	tok := globToken{kind: globClass}
+	i := 1
+	if i < len(p) && (p[i] == '!' || p[i] == '^') {
+		tok.negate = true
+		i++
+	}
+
+	first := true
+	for {
+		if i >= len(p) {
+			return tok, 0, ErrBadPattern
+		}
+
+		if p[i] == ']' && !first {
+			return tok, i + 1, nil
+		}
+
+		first = false
+		lo, n, ok := classRune(p[i:])
+		if !ok {
+			return tok, 0, ErrBadPattern
+		}
+
+		i += n
+		hi := lo
+		if i+1 < len(p) && p[i] == '-' && p[i+1] != ']' {
+			hi, n, ok = classRune(p[i+1:])
+			if !ok || hi < lo {
+				return tok, 0, ErrBadPattern
+			}
+
+			i += n + 1
+		}
+
+		tok.ranges = append(tok.ranges, globRange{lo: lo, hi: hi})
+	}
+}
+
+func classRune(p []rune) (rune, int, bool) {
+	if p[0] == '\\' {
+		if len(p) < 2 {
+			return 0, 0, false
+		}
+
+		return p[1], 2, true
+	}
+
+	return p[0], 1, true
+}
+
+// expandBraces expands the first top-level brace group of p and recurses, so
+// that "a{b,c{d,e}}" becomes "ab", "acd" and "ace".
+func expandBraces(p []rune) ([][]rune, error) {
+	start := -1
+	depth := 0
+	commas := make([]int, 0)
+	inClass := false
+	for i := 0; i < len(p); i++ {
+		r := p[i]
+		switch {
+		case r == '\\':
+			i++
+		case inClass:
+			if r == ']' {
+				inClass = false
+			}
+		case r == '[':
+			inClass = true
+			if i+1 < len(p) && (p[i+1] == '!' || p[i+1] == '^') {
+				i++
+			}
+
+			if i+1 < len(p) && p[i+1] == ']' {
+				i++
+			}
+		case r == '{':
+			if depth == 0 {
+				start = i
+			}
+
+			depth++
+		case r == ',' && depth == 1:
+			commas = append(commas, i)
+		case r == '}':
+			if depth == 0 {
+				return nil, ErrBadPattern
+			}
+
+			depth--
+			if depth > 0 {
+				continue
+			}
+
+			prefix := p[:start]
+			suffix := p[i+1:]
+
+			// As in shells, the braces of a group without a top-level comma
+			// are literal, but the groups inside it are still expanded.
+			if len(commas) == 0 {
+				return expandLiteralBraces(prefix, p[start+1:i], suffix)
+			}
+
+			bounds := append(append([]int{start}, commas...), i)
+			result := make([][]rune, 0)
+			for b := 0; b+1 < len(bounds); b++ {
+				alt := make([]rune, 0, len(prefix)+len(suffix)+bounds[b+1]-bounds[b])
+				alt = append(alt, prefix...)
+				alt = append(alt, p[bounds[b]+1:bounds[b+1]]...)
+				alt = append(alt, suffix...)
+
+				expanded, err := expandBraces(alt)
+				if err != nil {
+					return nil, err
+				}
+
+				result = append(result, expanded...)
+			}
+
+			return result, nil
+		}
+	}
+
+	if depth != 0 {
+		return nil, ErrBadPattern
+	}
+
+	return [][]rune{p}, nil
+}
+
+// expandLiteralBraces expands the groups of inner, which is enclosed in
+// literal braces, and of suffix, and joins every combination after prefix.
+func expandLiteralBraces(prefix, inner, suffix []rune) ([][]rune, error) {
+	inners, err := expandBraces(inner)
+	if err != nil {
+		return nil, err
+	}
+
+	suffixes, err := expandBraces(suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([][]rune, 0, len(inners)*len(suffixes))
+	for _, in := range inners {
+		for _, s := range suffixes {
+			alt := make([]rune, 0, len(prefix)+len(in)+len(s)+2)
+			alt = append(alt, prefix...)
+			alt = append(alt, '{')
+			alt = append(alt, in...)
+			alt = append(alt, '}')
+			alt = append(alt, s...)
+			result = append(result, alt)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,95 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		name     string
+		expected bool
+	}{
+		{"user-*-prod", "user-api-prod", true},
+		{"user-*-prod", "user--prod", true},
+		{"user-*-prod", "user-api-dev", false},
+		{"user-*-prod", "user-a/b-prod", false},
+		{"*.{yml,yaml}", "config.yml", true},
+		{"*.{yml,yaml}", "config.yaml", true},
+		{"*.{yml,yaml}", "config.json", false},
+		{"a{b,c{d,e}}f", "acef", true},
+		{"a{b,c{d,e}}f", "abf", true},
+		{"a{b,c{d,e}}f", "acf", false},
+		{"{a}", "{a}", true},
+		{"{a}", "a", false},
+		{"{}", "{}", true},
+		{"x{a}.{b,c}", "x{a}.c", true},
+		{"{x,{a}}", "{a}", true},
+		{"{x,{a}}", "a", false},
+		{"{a{b,c}}", "{ab}", true},
+		{"{a{b,c}}", "{ac}", true},
+		{"{a{b,c}}", "ab", false},
+		{"{a}{b,c}", "{a}c", true},
+		{"{{a,b}}x", "{b}x", true},
+		{"file?.txt", "file1.txt", true},
+		{"file?.txt", "file10.txt", false},
+		{"file[0-9].txt", "file7.txt", true},
+		{"file[!0-9].txt", "file7.txt", false},
+		{"file[^0-9].txt", "filex.txt", true},
+		{"[]a]", "]", true},
+		{"\\*", "*", true},
+		{"\\*", "x", false},
+		{"src/**/*.go", "src/main.go", true},
+		{"src/**/*.go", "src/a/b/main.go", true},
+		{"src/**/*.go", "srcx/main.go", false},
+		{"**/test", "test", true},
+		{"**/test", "a/b/test", true},
+		{"src/**", "src/a/b", true},
+		{"a**b", "a/x/b", true},
+		{"*", "", true},
+		{"", "", true},
+		{"", "a", false},
+		{"*a*a*a*a*a*a*a*b", "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", false},
+		{"ünï*", "ünïcode", true},
+	}
+
+	for _, tt := range tests {
+		ok, err := runes.Match([]rune(tt.pattern), []rune(tt.name))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, ok, "%s ~ %s", tt.pattern, tt.name)
+	}
+}
+
+func TestMatchFold(t *testing.T) {
+	ok, _ := runes.Match([]rune("USER-*-PROD"), []rune("user-api-prod"))
+	assert.False(t, ok)
+
+	ok, _ = runes.Match([]rune("USER-*-PROD"), []rune("user-api-prod"), runes.GlobFold)
+	assert.True(t, ok)
+
+	ok, _ = runes.Match([]rune("[a-c]x"), []rune("BX"), runes.GlobFold)
+	assert.True(t, ok)
+
+	ok, _ = runes.Match([]rune("[!a-c]x"), []rune("BX"), runes.GlobFold)
+	assert.False(t, ok)
+}
+
+func TestMatchBadPattern(t *testing.T) {
+	for _, p := range []string{"[abc", "{a,b", "a}", "abc\\", "[z-a]"} {
+		_, err := runes.Match([]rune(p), []rune("abc"))
+		assert.ErrorIs(t, err, runes.ErrBadPattern, p)
+	}
+
+	assert.Panics(t, func() { runes.MustCompileGlob([]rune("[")) })
+}
+
+func TestGlob(t *testing.T) {
+	g := runes.MustCompileGlob([]rune("*.{yml,yaml}"), runes.GlobFold)
+	assert.Equal(t, "*.{yml,yaml}", string(g.Pattern()))
+	assert.True(t, g.Match([]rune("A.YML")))
+	assert.True(t, g.Match([]rune("b.yaml")))
+	assert.False(t, g.Match([]rune("dir/b.yaml")))
+}
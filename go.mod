@@ -2,7 +2,10 @@ module github.com/jolt9dev/go-xrunes
 
 go 1.23.1
 
-require github.com/stretchr/testify v1.10.0
+require (
+	github.com/stretchr/testify v1.10.0
+	golang.org/x/text v0.28.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
@@ -0,0 +1,58 @@
+package xrunes
+
+import (
+	"hash/maphash"
+	"unicode/utf8"
+)
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// HashFold returns a hash of s that is insensitive to Unicode case-folding:
+// EqualFold(a, b) implies HashFold(seed, a) == HashFold(seed, b). The hash is
+// randomized by seed, like maphash, so it must not be persisted; use
+// HashFoldStable for values that outlive the process.
+func HashFold(seed maphash.Seed, s []rune) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+
+	buf := make([]byte, 0, 64)
+	for _, r := range s {
+		buf = utf8.AppendRune(buf, foldRune(r))
+		if len(buf) > 60 {
+			h.Write(buf)
+			buf = buf[:0]
+		}
+	}
+
+	h.Write(buf)
+	return h.Sum64()
+}
+
+// HashFoldStable returns a non-randomized hash of s that is insensitive to
+// Unicode case-folding: EqualFold(a, b) implies HashFoldStable(a) == HashFoldStable(b).
+//
+// The hash is the 64-bit FNV-1a of the UTF-8 encoding of s after replacing each
+// letter by the smallest letter of its simple case-folding orbit, so it is the
+// same across processes and platforms and can be persisted, for example to shard
+// data by case-insensitive names. It may change if a future Unicode version
+// changes the case-folding orbits of the runes involved.
+//
+// Example:
+//
+//	HashFoldStable([]rune("Users")) == HashFoldStable([]rune("USERS")) // true
+func HashFoldStable(s []rune) uint64 {
+	h := uint64(fnvOffset64)
+	var buf [utf8.UTFMax]byte
+	for _, r := range s {
+		n := utf8.EncodeRune(buf[:], foldRune(r))
+		for _, b := range buf[:n] {
+			h ^= uint64(b)
+			h *= fnvPrime64
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,56 @@
+package xrunes_test
+
+import (
+	"hash/fnv"
+	"hash/maphash"
+	"math/rand"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashFoldStable(t *testing.T) {
+	assert.Equal(t, runes.HashFoldStable([]rune("Users")), runes.HashFoldStable([]rune("USERS")))
+	assert.NotEqual(t, runes.HashFoldStable([]rune("Users")), runes.HashFoldStable([]rune("Groups")))
+
+	// The stable hash is FNV-1a over the folded UTF-8 encoding.
+	h := fnv.New64a()
+	h.Write([]byte("USERS"))
+	assert.Equal(t, h.Sum64(), runes.HashFoldStable([]rune("users")))
+
+	h = fnv.New64a()
+	assert.Equal(t, h.Sum64(), runes.HashFoldStable(nil))
+}
+
+func TestHashFold(t *testing.T) {
+	seed := maphash.MakeSeed()
+	assert.Equal(t, runes.HashFold(seed, []rune("Straße")), runes.HashFold(seed, []rune("STRAẞE")))
+	assert.NotEqual(t, runes.HashFold(seed, []rune("a")), runes.HashFold(seed, []rune("b")))
+
+	long := []rune("The Quick Brown Fox Jumps Over The Lazy Dog, Again And Again And Again")
+	lower := []rune("the quick brown fox jumps over the lazy dog, again and again and again")
+	assert.Equal(t, runes.HashFold(seed, long), runes.HashFold(seed, lower))
+}
+
+func TestHashFoldProperty(t *testing.T) {
+	seed := maphash.MakeSeed()
+	rng := rand.New(rand.NewSource(9))
+	alphabet := []rune("aAkKKsSſσςΣǅǄǆ1ßẞ")
+	gen := func() []rune {
+		r := make([]rune, rng.Intn(4))
+		for i := range r {
+			r[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return r
+	}
+
+	for i := 0; i < 5000; i++ {
+		a := gen()
+		b := gen()
+		if runes.EqualFold(a, b) {
+			assert.Equal(t, runes.HashFold(seed, a), runes.HashFold(seed, b), "%q %q", string(a), string(b))
+			assert.Equal(t, runes.HashFoldStable(a), runes.HashFoldStable(b), "%q %q", string(a), string(b))
+		}
+	}
+}
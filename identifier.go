@@ -0,0 +1,330 @@
+package xrunes
+
+import (
+	"strconv"
+	"unicode"
+)
+
+// CaseStyle is a naming convention for identifiers.
+type CaseStyle int
+
+const (
+	// CaseUnknown is an unrecognized style. ToIdentifier keeps the case of its
+	// input when given CaseUnknown.
+	CaseUnknown CaseStyle = iota
+	// CaseSnake is snake_case, as produced by Underscore.
+	CaseSnake
+	// CaseScreamingSnake is SCREAMING_SNAKE_CASE, as produced by Underscore with Screaming.
+	CaseScreamingSnake
+	// CaseKebab is kebab-case, as produced by Dasherize.
+	CaseKebab
+	// CaseCamel is camelCase, as produced by CamelCase.
+	CaseCamel
+	// CasePascal is PascalCase, as produced by PascalCase.
+	CasePascal
+	// CaseTrain is Train-Case, words capitalized and joined with '-'.
+	CaseTrain
+	// CaseDot is dot.case, lowercase words joined with '.'.
+	CaseDot
+	// CaseMixed is an identifier that combines several styles, such as
+	// "user_Name" or "user-name_id".
+	CaseMixed
+)
+
+// String returns the name of the style.
+func (style CaseStyle) String() string {
+	switch style {
+	case CaseUnknown:
+		return "unknown"
+	case CaseSnake:
+		return "snake"
+	case CaseScreamingSnake:
+		return "screaming_snake"
+	case CaseKebab:
+		return "kebab"
+	case CaseCamel:
+		return "camel"
+	case CasePascal:
+		return "pascal"
+	case CaseTrain:
+		return "train"
+	case CaseDot:
+		return "dot"
+	case CaseMixed:
+		return "mixed"
+	default:
+		return "CaseStyle(" + strconv.Itoa(int(style)) + ")"
+	}
+}
+
+// Language is a target programming language for ToIdentifier.
+type Language int
+
+const (
+	// LanguageGo targets Go identifiers.
+	LanguageGo Language = iota
+	// LanguageJava targets Java identifiers.
+	LanguageJava
+	// LanguagePython targets Python 3 identifiers.
+	LanguagePython
+	// LanguageJavaScript targets ECMAScript identifiers, including strict mode
+	// reserved words.
+	LanguageJavaScript
+	// LanguageSQL targets unquoted SQL identifiers.
+	LanguageSQL
+	// LanguageC targets C identifiers.
+	LanguageC
+)
+
+// String returns the name of the language.
+func (lang Language) String() string {
+	switch lang {
+	case LanguageGo:
+		return "Go"
+	case LanguageJava:
+		return "Java"
+	case LanguagePython:
+		return "Python"
+	case LanguageJavaScript:
+		return "JavaScript"
+	case LanguageSQL:
+		return "SQL"
+	case LanguageC:
+		return "C"
+	default:
+		return "Language(" + strconv.Itoa(int(lang)) + ")"
+	}
+}
+
+func keywordSet(words ...string) map[string]struct{} {
+	m := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		m[w] = struct{}{}
+	}
+
+	return m
+}
+
+var keywords = map[Language]map[string]struct{}{
+	LanguageGo: keywordSet(
+		"break", "case", "chan", "const", "continue", "default", "defer", "else",
+		"fallthrough", "for", "func", "go", "goto", "if", "import", "interface",
+		"map", "package", "range", "return", "select", "struct", "switch", "type", "var",
+	),
+	LanguageJava: keywordSet(
+		"abstract", "assert", "boolean", "break", "byte", "case", "catch", "char",
+		"class", "const", "continue", "default", "do", "double", "else", "enum",
+		"extends", "final", "finally", "float", "for", "goto", "if", "implements",
+		"import", "instanceof", "int", "interface", "long", "native", "new", "package",
+		"private", "protected", "public", "return", "short", "static", "strictfp",
+		"super", "switch", "synchronized", "this", "throw", "throws", "transient",
+		"try", "void", "volatile", "while", "true", "false", "null", "_",
+	),
+	LanguagePython: keywordSet(
+		"False", "None", "True", "and", "as", "assert", "async", "await", "break",
+		"class", "continue", "def", "del", "elif", "else", "except", "finally", "for",
+		"from", "global", "if", "import", "in", "is", "lambda", "nonlocal", "not",
+		"or", "pass", "raise", "return", "try", "while", "with", "yield",
+	),
+	LanguageJavaScript: keywordSet(
+		"await", "break", "case", "catch", "class", "const", "continue", "debugger",
+		"default", "delete", "do", "else", "enum", "export", "extends", "false",
+		"finally", "for", "function", "if", "implements", "import", "in", "instanceof",
+		"interface", "let", "new", "null", "package", "private", "protected", "public",
+		"return", "static", "super", "switch", "this", "throw", "true", "try",
+		"typeof", "var", "void", "while", "with", "yield",
+	),
+	// SQL keywords are case-insensitive and are stored in lowercase.
+	LanguageSQL: keywordSet(
+		"add", "all", "alter", "and", "any", "as", "asc", "between", "by", "case",
+		"cast", "check", "column", "constraint", "create", "cross", "current_date",
+		"current_time", "current_timestamp", "current_user", "database", "default",
+		"delete", "desc", "distinct", "drop", "else", "end", "except", "exists",
+		"false", "fetch", "for", "foreign", "from", "full", "grant", "group", "having",
+		"in", "index", "inner", "insert", "intersect", "into", "is", "join", "key",
+		"left", "like", "limit", "not", "null", "offset", "on", "or", "order", "outer",
+		"primary", "references", "revoke", "right", "select", "session_user", "set",
+		"table", "then", "to", "true", "union", "unique", "update", "user", "using",
+		"values", "view", "when", "where", "with",
+	),
+	LanguageC: keywordSet(
+		"auto", "break", "case", "char", "const", "continue", "default", "do",
+		"double", "else", "enum", "extern", "float", "for", "goto", "if", "inline",
+		"int", "long", "register", "restrict", "return", "short", "signed", "sizeof",
+		"static", "struct", "switch", "typedef", "union", "unsigned", "void",
+		"volatile", "while", "_Alignas", "_Alignof", "_Atomic", "_Bool", "_Complex",
+		"_Generic", "_Imaginary", "_Noreturn", "_Static_assert", "_Thread_local",
+	),
+}
+
+// IsKeyword reports whether s is a reserved word in lang. SQL keywords are
+// matched case-insensitively.
+func IsKeyword(s []rune, lang Language) bool {
+	key := string(s)
+	if lang == LanguageSQL {
+		key = string(toLowerRunes(s))
+	}
+
+	_, ok := keywords[lang][key]
+	return ok
+}
+
+// IsIdentifier reports whether s is a valid identifier in lang that is not a
+// reserved word.
+//
+// Go identifiers start with a letter or '_' followed by letters, digits and
+// '_' as reported by unicode.IsLetter and unicode.IsDigit. Java, Python and
+// JavaScript identifiers also allow combining marks and connector punctuation
+// after the first rune, and '$' for Java and JavaScript. SQL and C identifiers
+// are restricted to ASCII letters, digits and '_' for portability. The Go
+// blank identifier "_" is not an identifier that can be referenced, so it is
+// rejected.
+func IsIdentifier(s []rune, lang Language) bool {
+	if len(s) == 0 || lang == LanguageGo && len(s) == 1 && s[0] == '_' {
+		return false
+	}
+
+	for i, r := range s {
+		if !isIdentifierRune(r, i == 0, lang) {
+			return false
+		}
+	}
+
+	return !IsKeyword(s, lang)
+}
+
+// ToIdentifier converts arbitrary runes, such as a schema or column name, into
+// a valid identifier for lang in the given case style. It applies the case
+// transform, replaces runes that are not allowed by the language with '_',
+// transliterating them to ASCII first for SQL and C, prefixes a leading digit
+// with 'x' and escapes reserved words: SQL keywords are double quoted, while
+// keywords of the other languages get a trailing '_'. An input without any
+// usable rune becomes "x". The prefix is 'X' for CasePascal, CaseScreamingSnake
+// and CaseTrain, and Go names in those styles that do not start with an
+// uppercase letter, such as "東京", get it too so that they are exported.
+//
+// Example:
+//
+//	ToIdentifier([]rune("type"), LanguageGo, CaseCamel)         // "type_"
+//	ToIdentifier([]rune("2nd address"), LanguageGo, CasePascal) // "X2ndAddress"
+//	ToIdentifier([]rune("user"), LanguageSQL, CaseSnake)        // "\"user\""
+func ToIdentifier(runes []rune, lang Language, style CaseStyle) []rune {
+	if lang == LanguageSQL || lang == LanguageC {
+		runes = Transliterate(runes)
+	}
+
+	s := applyCaseStyle(runes, style)
+	sb := make([]rune, 0, len(s)+2)
+	for _, r := range s {
+		if !isIdentifierRune(r, false, lang) {
+			if r < unicode.MaxASCII || unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) {
+				r = '_'
+			} else {
+				continue
+			}
+		}
+
+		if r == '_' && len(sb) > 0 && sb[len(sb)-1] == '_' {
+			continue
+		}
+
+		sb = append(sb, r)
+	}
+
+	prefix := 'x'
+	switch style {
+	case CasePascal, CaseScreamingSnake, CaseTrain:
+		prefix = 'X'
+	}
+
+	if len(Trim(sb, []rune("_"))) == 0 {
+		return []rune{prefix}
+	}
+
+	// Go only exports names that start with an uppercase letter.
+	exported := lang == LanguageGo && prefix == 'X'
+	if !isIdentifierRune(sb[0], true, lang) || exported && !unicode.IsUpper(sb[0]) {
+		sb = append([]rune{prefix}, sb...)
+	}
+
+	if IsKeyword(sb, lang) {
+		if lang == LanguageSQL {
+			quoted := make([]rune, 0, len(sb)+2)
+			quoted = append(quoted, '"')
+			quoted = append(quoted, sb...)
+			return append(quoted, '"')
+		}
+
+		sb = append(sb, '_')
+	}
+
+	return sb
+}
+
+// applyCaseStyle applies the transform that produces style.
+func applyCaseStyle(runes []rune, style CaseStyle) []rune {
+	switch style {
+	case CaseSnake:
+		return Underscore(runes)
+	case CaseScreamingSnake:
+		return Underscore(runes, Screaming)
+	case CaseKebab:
+		return Dasherize(runes)
+	case CaseCamel:
+		return CamelCase(runes)
+	case CasePascal:
+		return PascalCase(runes)
+	case CaseTrain, CaseDot:
+		return Convert(runes, style)
+	default:
+		return runes
+	}
+}
+
+func isIdentifierRune(r rune, first bool, lang Language) bool {
+	if r == '_' {
+		return true
+	}
+
+	switch lang {
+	case LanguageSQL, LanguageC:
+		if r >= unicode.MaxASCII {
+			return false
+		}
+
+		if first {
+			return unicode.IsLetter(r)
+		}
+
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	case LanguageGo:
+		if first {
+			return unicode.IsLetter(r)
+		}
+
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	if r == '$' && (lang == LanguageJava || lang == LanguageJavaScript) {
+		return true
+	}
+
+	if unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) {
+		return true
+	}
+
+	if first {
+		return false
+	}
+
+	return unicode.IsDigit(r) || unicode.In(r, unicode.Mn, unicode.Mc, unicode.Pc)
+}
+
+func toLowerRunes(s []rune) []rune {
+	lower := make([]rune, len(s))
+	for i, r := range s {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	return lower
+}
@@ -0,0 +1,96 @@
+package xrunes_test
+
+import (
+	"go/token"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToIdentifier(t *testing.T) {
+	tests := []struct {
+		input    string
+		lang     runes.Language
+		style    runes.CaseStyle
+		expected string
+	}{
+		{"type", runes.LanguageGo, runes.CaseCamel, "type_"},
+		{"type", runes.LanguageGo, runes.CasePascal, "Type"},
+		{"2nd address", runes.LanguageGo, runes.CasePascal, "X2ndAddress"},
+		{"2nd address", runes.LanguageGo, runes.CaseCamel, "x2ndAddress"},
+		{"2nd address", runes.LanguageGo, runes.CaseScreamingSnake, "X2ND_ADDRESS"},
+		{"2nd address", runes.LanguagePython, runes.CaseSnake, "x2nd_address"},
+		{"user-name", runes.LanguageGo, runes.CaseSnake, "user_name"},
+		{"user-name", runes.LanguageGo, runes.CaseKebab, "user_name"},
+		{"crème brûlée", runes.LanguageGo, runes.CaseCamel, "crèmeBrûlée"},
+		{"crème brûlée", runes.LanguageC, runes.CaseCamel, "cremeBrulee"},
+		{"class", runes.LanguageJava, runes.CaseCamel, "class_"},
+		{"$price", runes.LanguageJava, runes.CaseUnknown, "$price"},
+		{"$price", runes.LanguageGo, runes.CaseUnknown, "_price"},
+		{"None", runes.LanguagePython, runes.CaseUnknown, "None_"},
+		{"none", runes.LanguagePython, runes.CaseUnknown, "none"},
+		{"let", runes.LanguageJavaScript, runes.CaseCamel, "let_"},
+		{"user", runes.LanguageSQL, runes.CaseSnake, "\"user\""},
+		{"Order", runes.LanguageSQL, runes.CasePascal, "\"Order\""},
+		{"order id", runes.LanguageSQL, runes.CaseSnake, "order_id"},
+		{"int", runes.LanguageC, runes.CaseSnake, "int_"},
+		{"max conns", runes.LanguageGo, runes.CaseScreamingSnake, "MAX_CONNS"},
+		{"max conns", runes.LanguageGo, runes.CaseTrain, "Max_Conns"},
+		{"a.b/c", runes.LanguageGo, runes.CaseUnknown, "a_b_c"},
+		{"!!!", runes.LanguageGo, runes.CaseCamel, "x"},
+		{"!!!", runes.LanguageGo, runes.CaseUnknown, "x"},
+		{"", runes.LanguageGo, runes.CaseCamel, "x"},
+		{"", runes.LanguageGo, runes.CasePascal, "X"},
+		{"", runes.LanguageJava, runes.CaseCamel, "x"},
+		{"", runes.LanguagePython, runes.CaseSnake, "x"},
+		{"東京", runes.LanguagePython, runes.CaseSnake, "東京"},
+		{"東京", runes.LanguageGo, runes.CasePascal, "X東京"},
+		{"東京", runes.LanguageGo, runes.CaseCamel, "東京"},
+		{"東京", runes.LanguageSQL, runes.CaseSnake, "x"},
+	}
+
+	for _, tt := range tests {
+		result := runes.ToIdentifier([]rune(tt.input), tt.lang, tt.style)
+		assert.Equal(t, tt.expected, string(result), "%s %s %s", tt.input, tt.lang, tt.style)
+		if tt.lang != runes.LanguageSQL || result[0] != '"' {
+			assert.True(t, runes.IsIdentifier(result, tt.lang), "%s is not a valid %s identifier", string(result), tt.lang)
+		}
+	}
+}
+
+func TestToIdentifierExportedGo(t *testing.T) {
+	for _, input := range []string{"", "!!!", "2nd address", "42", "user name", "東京"} {
+		result := runes.ToIdentifier([]rune(input), runes.LanguageGo, runes.CasePascal)
+		assert.True(t, token.IsExported(string(result)), "%q gives %q", input, string(result))
+		assert.True(t, token.IsIdentifier(string(result)), "%q gives %q", input, string(result))
+	}
+}
+
+func TestIsIdentifier(t *testing.T) {
+	assert.True(t, runes.IsIdentifier([]rune("userName"), runes.LanguageGo))
+	assert.True(t, runes.IsIdentifier([]rune("π"), runes.LanguageGo))
+	assert.False(t, runes.IsIdentifier([]rune("π"), runes.LanguageC))
+	assert.False(t, runes.IsIdentifier([]rune("1x"), runes.LanguageGo))
+	assert.False(t, runes.IsIdentifier([]rune("func"), runes.LanguageGo))
+	assert.False(t, runes.IsIdentifier([]rune("SELECT"), runes.LanguageSQL))
+	assert.False(t, runes.IsIdentifier([]rune(""), runes.LanguageGo))
+	assert.False(t, runes.IsIdentifier([]rune("_"), runes.LanguageGo))
+	assert.True(t, runes.IsIdentifier([]rune("_"), runes.LanguagePython))
+	assert.False(t, runes.IsIdentifier([]rune("a-b"), runes.LanguageJavaScript))
+	assert.True(t, runes.IsIdentifier([]rune("$el"), runes.LanguageJavaScript))
+}
+
+func TestIsKeyword(t *testing.T) {
+	assert.True(t, runes.IsKeyword([]rune("select"), runes.LanguageSQL))
+	assert.True(t, runes.IsKeyword([]rune("Select"), runes.LanguageSQL))
+	assert.False(t, runes.IsKeyword([]rune("Select"), runes.LanguageGo))
+	assert.True(t, runes.IsKeyword([]rune("_Bool"), runes.LanguageC))
+}
+
+func TestCaseStyleString(t *testing.T) {
+	assert.Equal(t, "pascal", runes.CasePascal.String())
+	assert.Equal(t, "train", runes.CaseTrain.String())
+	assert.Equal(t, "mixed", runes.CaseMixed.String())
+	assert.Equal(t, "Go", runes.LanguageGo.String())
+}
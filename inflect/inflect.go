@@ -0,0 +1,211 @@
+// Package inflect converts English words between singular and plural forms
+// and combines inflection with the case transforms of xrunes to derive table
+// names, type names and labels from identifiers.
+//
+// The package-level functions use the built-in English rules. Rules for
+// domain-specific words are registered on an Inflector created with New.
+package inflect
+
+import (
+	"strconv"
+	"unicode"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+	"github.com/jolt9dev/go-xrunes/internal/inflection"
+)
+
+// Inflector converts words with a set of English rules. Rules must be
+// registered before the Inflector is used concurrently.
+type Inflector struct {
+	rules *inflection.Rules
+}
+
+var english = &Inflector{rules: inflection.Default()}
+
+// New returns an Inflector with the built-in English rules, to which rules
+// can be added.
+//
+// Example:
+//
+//	in := New()
+//	in.Irregular([]rune("cactus"), []rune("cacti"))
+//	in.Uncountable([]rune("firmware"))
+//	in.Pluralize([]rune("cactus")) // "cacti"
+func New() *Inflector {
+	return &Inflector{rules: inflection.Default().Clone()}
+}
+
+// Plural adds a rule that replaces the matches of the regular expression
+// pattern in the last word with replacement, which can refer to submatches
+// as ${1}. Patterns are matched case-insensitively, and rules added later are
+// tried first. It returns an error when pattern does not compile.
+func (in *Inflector) Plural(pattern, replacement string) error {
+	return in.rules.Plural(pattern, replacement)
+}
+
+// Singular adds a rule used by Singularize, like Plural.
+func (in *Inflector) Singular(pattern, replacement string) error {
+	return in.rules.Singular(pattern, replacement)
+}
+
+// Irregular adds a word whose plural does not follow the rules.
+func (in *Inflector) Irregular(singular, plural []rune) {
+	in.rules.Irregular(string(singular), string(plural))
+}
+
+// Uncountable adds words whose plural and singular are the same, such as
+// "equipment".
+func (in *Inflector) Uncountable(words ...[]rune) {
+	for _, w := range words {
+		in.rules.Uncountable(string(w))
+	}
+}
+
+// Pluralize returns the plural of the last word of s, keeping its case. Words
+// are delimited by any rune that is not a letter and by camel humps.
+//
+// Example:
+//
+//	Pluralize([]rune("person"))       // "people"
+//	Pluralize([]rune("order_item"))   // "order_items"
+//	Pluralize([]rune("UserCategory")) // "UserCategories"
+func (in *Inflector) Pluralize(s []rune) []rune {
+	return []rune(in.rules.Pluralize(string(s)))
+}
+
+// Singularize returns the singular of the last word of s, like Pluralize.
+func (in *Inflector) Singularize(s []rune) []rune {
+	return []rune(in.rules.Singularize(string(s)))
+}
+
+// Tableize returns the table name of a type name: its snake case form with
+// the last word pluralized.
+//
+// Example:
+//
+//	Tableize([]rune("Person"))    // "people"
+//	Tableize([]rune("OrderItem")) // "order_items"
+func (in *Inflector) Tableize(s []rune) []rune {
+	return in.Pluralize(xrunes.Underscore(s))
+}
+
+// Classify returns the type name of a table name: its PascalCase form with
+// the last word singularized. A schema prefix, such as "public." in
+// "public.order_items", is removed.
+//
+// Example:
+//
+//	Classify([]rune("order_items"))   // "OrderItem"
+//	Classify([]rune("public.people")) // "Person"
+func (in *Inflector) Classify(s []rune) []rune {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == '.' {
+			s = s[i+1:]
+			break
+		}
+	}
+
+	return xrunes.PascalCase(in.Singularize(xrunes.Underscore(s)))
+}
+
+// Pluralize returns the plural of the last word of s with the English rules.
+func Pluralize(s []rune) []rune {
+	return english.Pluralize(s)
+}
+
+// Singularize returns the singular of the last word of s with the English rules.
+func Singularize(s []rune) []rune {
+	return english.Singularize(s)
+}
+
+// Tableize returns the table name of a type name with the English rules.
+func Tableize(s []rune) []rune {
+	return english.Tableize(s)
+}
+
+// Classify returns the type name of a table name with the English rules.
+func Classify(s []rune) []rune {
+	return english.Classify(s)
+}
+
+// HumanizeParams defines the parameters used by Humanize.
+type HumanizeParams struct {
+	// KeepID keeps a trailing "id" word instead of removing it.
+	KeepID bool
+}
+
+// HumanizeOption is a function type that modifies the options for HumanizeParams.
+type HumanizeOption func(params *HumanizeParams)
+
+// KeepID sets the KeepID field of the given HumanizeParams to true.
+func KeepID(params *HumanizeParams) {
+	params.KeepID = true
+}
+
+// Humanize returns a label for an identifier: its snake case form with the
+// underscores replaced by spaces and the first letter capitalized. A trailing
+// "id" word is removed, unless the KeepID option is given, so that a foreign
+// key is labelled with the name of what it refers to.
+//
+// Example:
+//
+//	Humanize([]rune("user_id"))         // "User"
+//	Humanize([]rune("user_id"), KeepID) // "User id"
+//	Humanize([]rune("createdAt"))       // "Created at"
+func Humanize(s []rune, options ...HumanizeOption) []rune {
+	params := &HumanizeParams{}
+	for _, option := range options {
+		option(params)
+	}
+
+	u := xrunes.Underscore(s)
+	if !params.KeepID && len(u) > 3 && string(u[len(u)-3:]) == "_id" {
+		u = u[:len(u)-3]
+	}
+
+	for i, r := range u {
+		if r == '_' {
+			u[i] = ' '
+		}
+	}
+
+	if len(u) > 0 {
+		u[0] = unicode.ToUpper(u[0])
+	}
+
+	return u
+}
+
+// Ordinalize returns n followed by its English ordinal suffix.
+//
+// Example:
+//
+//	Ordinalize(1)  // "1st"
+//	Ordinalize(12) // "12th"
+//	Ordinalize(23) // "23rd"
+func Ordinalize(n int) []rune {
+	return append([]rune(strconv.Itoa(n)), []rune(Ordinal(n))...)
+}
+
+// Ordinal returns the English ordinal suffix of n: "st", "nd", "rd" or "th".
+func Ordinal(n int) string {
+	if n < 0 {
+		n = -n
+	}
+
+	switch n % 100 {
+	case 11, 12, 13:
+		return "th"
+	}
+
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
@@ -0,0 +1,123 @@
+package inflect_test
+
+import (
+	"testing"
+
+	"github.com/jolt9dev/go-xrunes/inflect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var nouns = [][2]string{
+	{"user", "users"},
+	{"category", "categories"},
+	{"day", "days"},
+	{"box", "boxes"},
+	{"match", "matches"},
+	{"address", "addresses"},
+	{"status", "statuses"},
+	{"bus", "buses"},
+	{"quiz", "quizzes"},
+	{"wife", "wives"},
+	{"half", "halves"},
+	{"datum", "data"},
+	{"analysis", "analyses"},
+	{"crisis", "crises"},
+	{"matrix", "matrices"},
+	{"index", "indices"},
+	{"vertex", "vertices"},
+	{"octopus", "octopi"},
+	{"tomato", "tomatoes"},
+	{"photo", "photos"},
+	{"mouse", "mice"},
+	{"ox", "oxen"},
+	{"person", "people"},
+	{"child", "children"},
+	{"woman", "women"},
+	{"movie", "movies"},
+	{"database", "databases"},
+	{"equipment", "equipment"},
+	{"sheep", "sheep"},
+	{"news", "news"},
+}
+
+func TestPluralize(t *testing.T) {
+	for _, n := range nouns {
+		assert.Equal(t, n[1], string(inflect.Pluralize([]rune(n[0]))), n[0])
+		// already plural words are kept.
+		assert.Equal(t, n[1], string(inflect.Pluralize([]rune(n[1]))), n[1])
+	}
+
+	assert.Equal(t, "order_items", string(inflect.Pluralize([]rune("order_item"))))
+	assert.Equal(t, "UserCategories", string(inflect.Pluralize([]rune("UserCategory"))))
+	assert.Equal(t, "SalesPeople", string(inflect.Pluralize([]rune("SalesPerson"))))
+	assert.Equal(t, "People", string(inflect.Pluralize([]rune("Person"))))
+	assert.Equal(t, "CATEGORIES", string(inflect.Pluralize([]rune("CATEGORY"))))
+	assert.Equal(t, "", string(inflect.Pluralize(nil)))
+	assert.Equal(t, "item_2", string(inflect.Pluralize([]rune("item_2"))))
+}
+
+func TestSingularize(t *testing.T) {
+	for _, n := range nouns {
+		assert.Equal(t, n[0], string(inflect.Singularize([]rune(n[1]))), n[1])
+		assert.Equal(t, n[0], string(inflect.Singularize([]rune(n[0]))), n[0])
+	}
+
+	assert.Equal(t, "order_item", string(inflect.Singularize([]rune("order_items"))))
+	assert.Equal(t, "SalesPerson", string(inflect.Singularize([]rune("SalesPeople"))))
+}
+
+func TestInflector(t *testing.T) {
+	in := inflect.New()
+	in.Irregular([]rune("cactus"), []rune("cacti"))
+	in.Uncountable([]rune("firmware"))
+	require.NoError(t, in.Plural(`(ax)e$`, `${1}en`))
+	require.NoError(t, in.Singular(`(ax)en$`, `${1}e`))
+	assert.Error(t, in.Plural(`(`, ``))
+
+	assert.Equal(t, "cacti", string(in.Pluralize([]rune("cactus"))))
+	assert.Equal(t, "Cactus", string(in.Singularize([]rune("Cacti"))))
+	assert.Equal(t, "firmware", string(in.Pluralize([]rune("firmware"))))
+	assert.Equal(t, "axen", string(in.Pluralize([]rune("axe"))))
+	assert.Equal(t, "axe", string(in.Singularize([]rune("axen"))))
+	assert.Equal(t, "people", string(in.Pluralize([]rune("person"))))
+
+	// the package-level rules are not changed.
+	assert.Equal(t, "cactus", string(inflect.Pluralize([]rune("cactus"))))
+	assert.Equal(t, "firmwares", string(inflect.Pluralize([]rune("firmware"))))
+}
+
+func TestTableize(t *testing.T) {
+	assert.Equal(t, "people", string(inflect.Tableize([]rune("Person"))))
+	assert.Equal(t, "order_items", string(inflect.Tableize([]rune("OrderItem"))))
+	assert.Equal(t, "httprequests", string(inflect.Tableize([]rune("HTTPRequest"))))
+	assert.Equal(t, "user_categories", string(inflect.Tableize([]rune("userCategory"))))
+}
+
+func TestClassify(t *testing.T) {
+	assert.Equal(t, "OrderItem", string(inflect.Classify([]rune("order_items"))))
+	assert.Equal(t, "Person", string(inflect.Classify([]rune("public.people"))))
+	assert.Equal(t, "UserCategory", string(inflect.Classify([]rune("user_categories"))))
+	assert.Equal(t, "Equipment", string(inflect.Classify([]rune("equipment"))))
+}
+
+func TestHumanize(t *testing.T) {
+	assert.Equal(t, "User", string(inflect.Humanize([]rune("user_id"))))
+	assert.Equal(t, "User id", string(inflect.Humanize([]rune("user_id"), inflect.KeepID)))
+	assert.Equal(t, "Employee salary", string(inflect.Humanize([]rune("employee_salary"))))
+	assert.Equal(t, "Created at", string(inflect.Humanize([]rune("createdAt"))))
+	assert.Equal(t, "Author", string(inflect.Humanize([]rune("AuthorID"))))
+	assert.Equal(t, "Id", string(inflect.Humanize([]rune("id"))))
+	assert.Equal(t, "", string(inflect.Humanize(nil)))
+}
+
+func TestOrdinalize(t *testing.T) {
+	tests := map[int]string{
+		0: "0th", 1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th",
+		21: "21st", 22: "22nd", 23: "23rd", 101: "101st", 111: "111th", 112: "112th", -1: "-1st",
+	}
+
+	for n, expected := range tests {
+		assert.Equal(t, expected, string(inflect.Ordinalize(n)), n)
+	}
+}
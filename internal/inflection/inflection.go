@@ -0,0 +1,274 @@
+// Package inflection holds the English plural and singular rules shared by
+// xrunes.FuncMap and the inflect package.
+package inflection
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type rule struct {
+	re   *regexp.Regexp
+	repl string
+}
+
+// Rules is a set of inflection rules. Rules added later take precedence over
+// earlier ones. A Rules must not be modified while it is used concurrently.
+type Rules struct {
+	plurals      []rule
+	singulars    []rule
+	irregulars   map[string]string
+	singularOf   map[string]string
+	uncountables map[string]bool
+}
+
+var defaultRules = English()
+
+// Default returns the English rules. They must not be modified.
+func Default() *Rules {
+	return defaultRules
+}
+
+// New returns an empty set of rules.
+func New() *Rules {
+	return &Rules{
+		irregulars:   make(map[string]string),
+		singularOf:   make(map[string]string),
+		uncountables: make(map[string]bool),
+	}
+}
+
+// English returns a new set of rules for English nouns.
+func English() *Rules {
+	r := New()
+	for _, p := range [][2]string{
+		{`$`, `s`},
+		{`s$`, `s`},
+		{`^(ax|test)is$`, `${1}es`},
+		{`(octop|vir)us$`, `${1}i`},
+		{`(octop|vir)i$`, `${1}i`},
+		{`(alias|status|campus)$`, `${1}es`},
+		{`(bu)s$`, `${1}ses`},
+		{`(buffal|tomat|potat|her|ech)o$`, `${1}oes`},
+		{`([ti])um$`, `${1}a`},
+		{`([ti])a$`, `${1}a`},
+		{`sis$`, `ses`},
+		{`(?:([^f])fe|([lr])f)$`, `${1}${2}ves`},
+		{`(hive)$`, `${1}s`},
+		{`([^aeiouy]|qu)y$`, `${1}ies`},
+		{`(x|ch|ss|sh|z)$`, `${1}es`},
+		{`(matr|vert|ind)(?:ix|ex)$`, `${1}ices`},
+		{`^(m|l)ouse$`, `${1}ice`},
+		{`^(m|l)ice$`, `${1}ice`},
+		{`^(ox)$`, `${1}en`},
+		{`^(oxen)$`, `${1}`},
+		{`(quiz)$`, `${1}zes`},
+	} {
+		r.MustPlural(p[0], p[1])
+	}
+
+	for _, s := range [][2]string{
+		{`s$`, ``},
+		{`(ss)$`, `${1}`},
+		{`(n)ews$`, `${1}ews`},
+		{`([ti])a$`, `${1}um`},
+		{`((a)naly|(b)a|(d)iagno|(p)arenthe|(p)rogno|(s)ynop|(t)he)(sis|ses)$`, `${1}sis`},
+		{`(^analy)(sis|ses)$`, `${1}sis`},
+		{`([^f])ves$`, `${1}fe`},
+		{`(hive)s$`, `${1}`},
+		{`(tive)s$`, `${1}`},
+		{`([lr])ves$`, `${1}f`},
+		{`([^aeiouy]|qu)ies$`, `${1}y`},
+		{`(s)eries$`, `${1}eries`},
+		{`(m)ovies$`, `${1}ovie`},
+		{`(x|ch|ss|sh|z)es$`, `${1}`},
+		{`^(m|l)ice$`, `${1}ouse`},
+		{`(bus|campus)(es)?$`, `${1}`},
+		{`(o)es$`, `${1}`},
+		{`(shoe)s$`, `${1}`},
+		{`(cris|test)(is|es)$`, `${1}is`},
+		{`^(a)x[ie]s$`, `${1}xis`},
+		{`(octop|vir)(us|i)$`, `${1}us`},
+		{`(alias|status)(es)?$`, `${1}`},
+		{`^(ox)en`, `${1}`},
+		{`(vert|ind)ices$`, `${1}ex`},
+		{`(matr)ices$`, `${1}ix`},
+		{`(quiz)zes$`, `${1}`},
+		{`(database)s$`, `${1}`},
+	} {
+		r.MustSingular(s[0], s[1])
+	}
+
+	for _, w := range [][2]string{
+		{"person", "people"},
+		{"man", "men"},
+		{"woman", "women"},
+		{"child", "children"},
+		{"foot", "feet"},
+		{"tooth", "teeth"},
+		{"goose", "geese"},
+		{"sex", "sexes"},
+		{"move", "moves"},
+		{"zombie", "zombies"},
+	} {
+		r.Irregular(w[0], w[1])
+	}
+
+	r.Uncountable("equipment", "information", "rice", "money", "species", "series",
+		"fish", "sheep", "deer", "jeans", "police", "news", "metadata")
+	return r
+}
+
+// Clone returns a copy of r that can be modified independently.
+func (r *Rules) Clone() *Rules {
+	c := New()
+	c.plurals = append(c.plurals, r.plurals...)
+	c.singulars = append(c.singulars, r.singulars...)
+	for k, v := range r.irregulars {
+		c.irregulars[k] = v
+	}
+
+	for k, v := range r.singularOf {
+		c.singularOf[k] = v
+	}
+
+	for k := range r.uncountables {
+		c.uncountables[k] = true
+	}
+
+	return c
+}
+
+// Plural adds a rule that replaces the matches of pattern, matched
+// case-insensitively against the last word, with replacement, which may
+// refer to submatches as in regexp.Regexp.Expand.
+func (r *Rules) Plural(pattern, replacement string) error {
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return err
+	}
+
+	r.plurals = append(r.plurals, rule{re, replacement})
+	return nil
+}
+
+// Singular adds a singular rule, like Plural.
+func (r *Rules) Singular(pattern, replacement string) error {
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return err
+	}
+
+	r.singulars = append(r.singulars, rule{re, replacement})
+	return nil
+}
+
+// MustPlural is like Plural but panics if pattern does not compile.
+func (r *Rules) MustPlural(pattern, replacement string) {
+	if err := r.Plural(pattern, replacement); err != nil {
+		panic(err)
+	}
+}
+
+// MustSingular is like Singular but panics if pattern does not compile.
+func (r *Rules) MustSingular(pattern, replacement string) {
+	if err := r.Singular(pattern, replacement); err != nil {
+		panic(err)
+	}
+}
+
+// Irregular adds a word whose plural does not follow the rules.
+func (r *Rules) Irregular(singular, plural string) {
+	singular, plural = strings.ToLower(singular), strings.ToLower(plural)
+	delete(r.uncountables, singular)
+	delete(r.uncountables, plural)
+	r.irregulars[singular] = plural
+	r.singularOf[plural] = singular
+}
+
+// Uncountable adds words that have the same plural and singular forms.
+func (r *Rules) Uncountable(words ...string) {
+	for _, w := range words {
+		r.uncountables[strings.ToLower(w)] = true
+	}
+}
+
+// Pluralize returns the plural of the last word of s. Words are delimited by
+// any rune that is not a letter and by camel humps, so "order_item" and
+// "OrderItem" become "order_items" and "OrderItems".
+func (r *Rules) Pluralize(s string) string {
+	return r.inflect(s, r.plurals, r.irregulars, r.singularOf)
+}
+
+// Singularize returns the singular of the last word of s, like Pluralize.
+func (r *Rules) Singularize(s string) string {
+	return r.inflect(s, r.singulars, r.singularOf, r.irregulars)
+}
+
+// inflect applies irregulars, then rules, to the last word of s. inflected
+// maps the irregular words that are already in the target form.
+func (r *Rules) inflect(s string, rules []rule, irregulars, inflected map[string]string) string {
+	prefix, word := splitLastWord(s)
+	if word == "" {
+		return s
+	}
+
+	lower := strings.ToLower(word)
+	if r.uncountables[lower] {
+		return s
+	}
+
+	if w, ok := irregulars[lower]; ok {
+		return prefix + matchCase(word, w)
+	}
+
+	// an irregular word that is already inflected, such as "people", is kept.
+	if _, ok := inflected[lower]; ok {
+		return s
+	}
+
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].re.MatchString(word) {
+			return prefix + matchCase(word, rules[i].re.ReplaceAllString(word, rules[i].repl))
+		}
+	}
+
+	return s
+}
+
+// splitLastWord splits s before its last word: the trailing run of letters,
+// starting at its last camel hump.
+func splitLastWord(s string) (string, string) {
+	rs := []rune(s)
+	start := len(rs)
+	for start > 0 && unicode.IsLetter(rs[start-1]) {
+		start--
+		if start == 0 || !unicode.IsUpper(rs[start]) {
+			continue
+		}
+
+		// a camel hump, or the end of an acronym such as the "R" of "HTTPRequest".
+		if unicode.IsLower(rs[start-1]) || unicode.IsUpper(rs[start-1]) && start+1 < len(rs) && unicode.IsLower(rs[start+1]) {
+			break
+		}
+	}
+
+	return string(rs[:start]), string(rs[start:])
+}
+
+// matchCase returns inflected in the case of word: uppercase when word is
+// uppercase, capitalized when word is, and as is otherwise.
+func matchCase(word, inflected string) string {
+	rs := []rune(word)
+	if len(rs) > 1 && strings.ToUpper(word) == word {
+		return strings.ToUpper(inflected)
+	}
+
+	out := []rune(inflected)
+	if len(out) > 0 && unicode.IsUpper(rs[0]) {
+		out[0] = unicode.ToUpper(out[0])
+	}
+
+	return string(out)
+}
@@ -0,0 +1,36 @@
+package inflection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLastWord(t *testing.T) {
+	tests := [][3]string{
+		{"order_item", "order_", "item"},
+		{"OrderItem", "Order", "Item"},
+		{"HTTPRequest", "HTTP", "Request"},
+		{"user", "", "user"},
+		{"item_2", "item_2", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		prefix, word := splitLastWord(tt[0])
+		assert.Equal(t, tt[1], prefix, tt[0])
+		assert.Equal(t, tt[2], word, tt[0])
+	}
+}
+
+func TestClone(t *testing.T) {
+	r := English()
+	c := r.Clone()
+	c.Irregular("cactus", "cacti")
+	c.Uncountable("firmware")
+
+	assert.Equal(t, "cacti", c.Pluralize("cactus"))
+	assert.Equal(t, "firmware", c.Pluralize("firmware"))
+	assert.Equal(t, "cactus", r.Pluralize("cactus"))
+	assert.Equal(t, "firmwares", r.Pluralize("firmware"))
+}
@@ -0,0 +1,46 @@
+// Package textwidth measures the number of columns text occupies in a
+// monospace terminal. It is shared by the xrunes command and xrunes.FuncMap.
+package textwidth
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// Rune returns the number of columns r occupies: 0 for control characters,
+// combining marks, zero-width format characters and Hangul medial vowels and
+// final consonants, 2 for wide and fullwidth East Asian characters such as CJK
+// ideographs, Hangul syllables and emoji, and 1 otherwise. Characters whose
+// East Asian width is ambiguous are counted as 1, as in non-CJK locales.
+func Rune(r rune) int {
+	switch {
+	case r < 0x20 || r >= 0x7F && r < 0xA0:
+		return 0
+	case r < 0x7F:
+		return 1
+	case r == 0xAD:
+		// the soft hyphen is a format character that terminals display.
+		return 1
+	case r == 0x200B, r >= 0x1160 && r <= 0x11FF, unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// Runes returns the number of columns runes occupies, the sum of the width
+// of its runes.
+func Runes(runes []rune) int {
+	w := 0
+	for _, r := range runes {
+		w += Rune(r)
+	}
+
+	return w
+}
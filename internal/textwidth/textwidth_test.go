@@ -0,0 +1,43 @@
+package textwidth_test
+
+import (
+	"testing"
+
+	"github.com/jolt9dev/go-xrunes/internal/textwidth"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRune(t *testing.T) {
+	tests := []struct {
+		r        rune
+		expected int
+	}{
+		{'a', 1},
+		{'\t', 0},
+		{0x7F, 0},
+		{'é', 1},
+		{0x301, 0},
+		{0x200B, 0},
+		{0xAD, 1},
+		{'語', 2},
+		{'한', 2},
+		{0x1161, 0},
+		{'ｱ', 1},
+		{'Ａ', 2},
+		{'😀', 2},
+		{'€', 1},
+		{0x3099, 0},
+		{0x20000, 2},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, textwidth.Rune(tt.r), "%U", tt.r)
+	}
+}
+
+func TestRunes(t *testing.T) {
+	assert.Equal(t, 6, textwidth.Runes([]rune("Go言語")))
+	assert.Equal(t, 4, textwidth.Runes([]rune("café")))
+	assert.Equal(t, 4, textwidth.Runes([]rune("한국")))
+	assert.Equal(t, 0, textwidth.Runes(nil))
+}
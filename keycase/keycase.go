@@ -0,0 +1,285 @@
+// Package keycase renames the object keys of decoded JSON or YAML values and
+// of JSON streams with the case transforms of xrunes, so that Go structs can
+// be exposed as snake_case or kebab-case documents without writing tags.
+package keycase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+)
+
+// Transform converts a key to another case.
+type Transform func(key []rune) []rune
+
+var (
+	// Snake converts keys with xrunes.Underscore.
+	Snake Transform = func(key []rune) []rune { return xrunes.Underscore(key) }
+
+	// ScreamingSnake converts keys with xrunes.Underscore and xrunes.Screaming.
+	ScreamingSnake Transform = func(key []rune) []rune { return xrunes.Underscore(key, xrunes.Screaming) }
+
+	// Kebab converts keys with xrunes.Dasherize.
+	Kebab Transform = func(key []rune) []rune { return xrunes.Dasherize(key) }
+
+	// Camel converts keys with xrunes.CamelCase.
+	Camel Transform = xrunes.CamelCase
+
+	// Pascal converts keys with xrunes.PascalCase.
+	Pascal Transform = xrunes.PascalCase
+)
+
+// Params defines the parameters used by Rename and RenameJSON.
+type Params struct {
+	// Skip lists keys that are kept as is. Their values are still renamed.
+	Skip []string
+
+	// SkipValues lists keys whose values are copied without renaming, such as
+	// "labels" or "metadata" objects holding user-defined keys. The keys
+	// themselves are still renamed unless they are also listed in Skip.
+	SkipValues []string
+}
+
+// Option is a function type that modifies the options for Params.
+type Option func(params *Params)
+
+// Skip returns an Option that keeps the given keys unchanged.
+func Skip(keys ...string) Option {
+	return func(params *Params) {
+		params.Skip = append(params.Skip, keys...)
+	}
+}
+
+// SkipValues returns an Option that copies the values of the given keys
+// without renaming the keys nested in them.
+func SkipValues(keys ...string) Option {
+	return func(params *Params) {
+		params.SkipValues = append(params.SkipValues, keys...)
+	}
+}
+
+// Rename returns a copy of v with every key of its nested map[string]any
+// values converted by t. Slices of type []any are walked; any other value is
+// returned as is. Keys are matched against the skip-lists before they are
+// converted.
+//
+// Rename returns an error when two keys of the same object convert to the
+// same name, such as "userName" and "user_name" with Snake.
+//
+// Example:
+//
+//	var v any
+//	_ = json.Unmarshal([]byte(`{"UserName":"ada","Tags":[{"TagID":1}]}`), &v)
+//	v, _ = Rename(v, Snake)
+//	// map[tags:[map[tag_id:1]] user_name:ada]
+func Rename(v any, t Transform, options ...Option) (any, error) {
+	params := &Params{}
+	for _, option := range options {
+		option(params)
+	}
+
+	return rename(v, t, params)
+}
+
+func rename(v any, t Transform, params *Params) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		from := make(map[string]string, len(v))
+		// sort the keys so that the reported duplicate does not depend on
+		// the map iteration order.
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+		for _, key := range keys {
+			name := params.key(key, t)
+			if prev, ok := from[name]; ok {
+				return nil, fmt.Errorf("keycase: keys %q and %q both rename to %q", prev, key, name)
+			}
+
+			from[name] = key
+			value := v[key]
+			if !slices.Contains(params.SkipValues, key) {
+				var err error
+				value, err = rename(value, t, params)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			m[name] = value
+		}
+
+		return m, nil
+	case []any:
+		s := make([]any, len(v))
+		for i, value := range v {
+			value, err := rename(value, t, params)
+			if err != nil {
+				return nil, err
+			}
+
+			s[i] = value
+		}
+
+		return s, nil
+	default:
+		return v, nil
+	}
+}
+
+// RenameJSON copies the JSON values read from r to w, with every object key
+// converted by t. The values are streamed token by token with a json.Decoder
+// and written as they are rewritten, so documents do not need to fit in
+// memory, numbers keep their spelling and the order of keys is preserved;
+// only the values of SkipValues keys are read whole. Duplicate keys are
+// written as they come. The output is compact, with one line per top-level
+// value. On error, the part of the value read so far may have been written.
+func RenameJSON(w io.Writer, r io.Reader, t Transform, options ...Option) error {
+	params := &Params{}
+	for _, option := range options {
+		option(params)
+	}
+
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	s := &stream{w: bufio.NewWriter(w), t: t, params: params}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if len(s.stack) > 0 {
+				return io.ErrUnexpectedEOF
+			}
+
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := s.token(dec, tok); err != nil {
+			return err
+		}
+	}
+}
+
+// frame is an object or array being written by a stream.
+type frame struct {
+	object bool
+	n      int
+}
+
+// stream writes the tokens of a json.Decoder with renamed keys.
+type stream struct {
+	w      *bufio.Writer
+	t      Transform
+	params *Params
+	stack  []frame
+	// key is true when the next string token of an object is a key.
+	key bool
+}
+
+func (s *stream) token(dec *json.Decoder, tok json.Token) error {
+	delim, isDelim := tok.(json.Delim)
+	closing := isDelim && (delim == '}' || delim == ']')
+	if len(s.stack) > 0 && !closing {
+		top := &s.stack[len(s.stack)-1]
+		if s.key {
+			key, _ := tok.(string)
+			return s.writeKey(dec, top, key)
+		}
+
+		if !top.object && top.n > 0 {
+			s.w.WriteByte(',')
+		}
+
+		top.n++
+	}
+
+	switch tok := tok.(type) {
+	case json.Delim:
+		switch tok {
+		case '{':
+			s.stack = append(s.stack, frame{object: true})
+		case '[':
+			s.stack = append(s.stack, frame{})
+		default:
+			s.stack = s.stack[:len(s.stack)-1]
+		}
+
+		s.w.WriteString(tok.String())
+	case json.Number:
+		s.w.WriteString(tok.String())
+	default:
+		b, err := json.Marshal(tok)
+		if err != nil {
+			return err
+		}
+
+		s.w.Write(b)
+	}
+
+	return s.next()
+}
+
+func (s *stream) writeKey(dec *json.Decoder, top *frame, key string) error {
+	if top.n > 0 {
+		s.w.WriteByte(',')
+	}
+
+	top.n++
+	b, err := json.Marshal(s.params.key(key, s.t))
+	if err != nil {
+		return err
+	}
+
+	s.w.Write(b)
+	s.w.WriteByte(':')
+	s.key = false
+	if !slices.Contains(s.params.SkipValues, key) {
+		return nil
+	}
+
+	var raw json.RawMessage
+	if err := dec.Decode(&raw); err != nil {
+		return err
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, raw); err != nil {
+		return err
+	}
+
+	s.w.Write(compact.Bytes())
+	return s.next()
+}
+
+// next updates the state once a token has been written and flushes the
+// writer when a top-level value is complete. Errors of the writer are sticky,
+// so they are reported by the next flush.
+func (s *stream) next() error {
+	if len(s.stack) > 0 {
+		s.key = s.stack[len(s.stack)-1].object
+		return nil
+	}
+
+	s.w.WriteByte('\n')
+	return s.w.Flush()
+}
+
+func (params *Params) key(key string, t Transform) string {
+	if slices.Contains(params.Skip, key) {
+		return key
+	}
+
+	return string(t([]rune(key)))
+}
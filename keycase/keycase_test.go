@@ -0,0 +1,123 @@
+package keycase_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+	"github.com/jolt9dev/go-xrunes/keycase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	require.NoError(t, json.Unmarshal([]byte(s), &v))
+	return v
+}
+
+func TestRename(t *testing.T) {
+	v := decode(t, `{"UserName":"ada","HTTPServer":{"ReadTimeout":5},"Tags":[{"TagID":1},"TagName"]}`)
+	got, err := keycase.Rename(v, keycase.Snake)
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"user_name":"ada","httpserver":{"read_timeout":5},"tags":[{"tag_id":1},"TagName"]}`), got)
+
+	got, err = keycase.Rename(v, keycase.Kebab)
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"user-name":"ada","httpserver":{"read-timeout":5},"tags":[{"tag-id":1},"TagName"]}`), got)
+
+	got, err = keycase.Rename(decode(t, `{"user_name":{"first_name":"ada"}}`), keycase.Camel)
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"userName":{"firstName":"ada"}}`), got)
+
+	got, err = keycase.Rename(decode(t, `{"user_name":1}`), keycase.Pascal)
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"UserName":1}`), got)
+
+	// the input is not modified.
+	assert.Equal(t, decode(t, `{"UserName":"ada","HTTPServer":{"ReadTimeout":5},"Tags":[{"TagID":1},"TagName"]}`), v)
+}
+
+func TestRenameSplitAcronyms(t *testing.T) {
+	snake := func(key []rune) []rune { return xrunes.Underscore(key, xrunes.SplitAcronyms) }
+	got, err := keycase.Rename(decode(t, `{"HTTPServer":{"TLSConfig":1}}`), snake)
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"http_server":{"tls_config":1}}`), got)
+}
+
+func TestRenameSkip(t *testing.T) {
+	v := decode(t, `{"APIVersion":"v1","Metadata":{"Labels":{"AppName":"web"}},"Spec":{"ReplicaCount":2}}`)
+	got, err := keycase.Rename(v, keycase.Snake, keycase.Skip("APIVersion"), keycase.SkipValues("Labels"))
+	require.NoError(t, err)
+	assert.Equal(t, decode(t, `{"APIVersion":"v1","metadata":{"labels":{"AppName":"web"}},"spec":{"replica_count":2}}`), got)
+}
+
+func TestRenameDuplicate(t *testing.T) {
+	_, err := keycase.Rename(decode(t, `{"userName":1,"user_name":2}`), keycase.Snake)
+	assert.EqualError(t, err, `keycase: keys "userName" and "user_name" both rename to "user_name"`)
+}
+
+func TestRenameJSON(t *testing.T) {
+	src := `{"UserName": "ada", "Score": 1.50, "Roles": ["Admin", {"RoleID": 7}], "Empty": {}, "None": [], "Nil": null, "OK": true}
+[{"TagID": 1}, 2]
+"PlainString"`
+	var out bytes.Buffer
+	require.NoError(t, keycase.RenameJSON(&out, strings.NewReader(src), keycase.Snake))
+	assert.Equal(t, `{"user_name":"ada","score":1.50,"roles":["Admin",{"role_id":7}],"empty":{},"none":[],"nil":null,"ok":true}
+[{"tag_id":1},2]
+"PlainString"
+`, out.String())
+}
+
+func TestRenameJSONSkip(t *testing.T) {
+	src := `{"Metadata": {"Labels": {"AppName": "web", "Tier": [1, 2]}, "OwnerName": "ada"}, "APIVersion": "v1"}`
+	var out bytes.Buffer
+	require.NoError(t, keycase.RenameJSON(&out, strings.NewReader(src), keycase.Kebab,
+		keycase.Skip("APIVersion"), keycase.SkipValues("Labels")))
+	assert.Equal(t, `{"metadata":{"labels":{"AppName":"web","Tier":[1,2]},"owner-name":"ada"},"APIVersion":"v1"}`+"\n", out.String())
+}
+
+func TestRenameJSONMatchesRename(t *testing.T) {
+	src := `{"UserName":"ada","HTTPServer":{"ReadTimeout":5,"Hosts":["a",{"HostName":"b"}]}}`
+	var out bytes.Buffer
+	require.NoError(t, keycase.RenameJSON(&out, strings.NewReader(src), keycase.ScreamingSnake))
+
+	want, err := keycase.Rename(decode(t, src), keycase.ScreamingSnake)
+	require.NoError(t, err)
+	assert.Equal(t, want, decode(t, out.String()))
+}
+
+func TestRenameJSONStreams(t *testing.T) {
+	// the output of a large value is written before its end is read.
+	var out bytes.Buffer
+	written := -1
+	tail := readerFunc(func(p []byte) (int, error) {
+		if written < 0 {
+			written = out.Len()
+		}
+
+		return 0, io.EOF
+	})
+
+	src := "[" + strings.Repeat(`{"UserName":"ada"},`, 10000) + `{"UserName":"ada"}`
+	r := io.MultiReader(strings.NewReader(src), tail, strings.NewReader("]"))
+	require.NoError(t, keycase.RenameJSON(&out, r, keycase.Snake))
+	assert.Greater(t, written, 0)
+	assert.Equal(t, "["+strings.Repeat(`{"user_name":"ada"},`, 10000)+`{"user_name":"ada"}]`+"\n", out.String())
+}
+
+type readerFunc func(p []byte) (int, error)
+
+func (f readerFunc) Read(p []byte) (int, error) {
+	return f(p)
+}
+
+func TestRenameJSONErrors(t *testing.T) {
+	var out bytes.Buffer
+	assert.Error(t, keycase.RenameJSON(&out, strings.NewReader(`{"UserName": }`), keycase.Snake))
+	assert.Error(t, keycase.RenameJSON(&out, strings.NewReader(`{"UserName": 1`), keycase.Snake))
+}
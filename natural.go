@@ -0,0 +1,153 @@
+package xrunes
+
+import (
+	"slices"
+	"unicode"
+)
+
+// CompareNatural compares a and b in natural order, where runs of decimal
+// digits are compared by their numeric value instead of rune by rune, so that
+// "file2" sorts before "file10". Any Unicode decimal digit is supported, as
+// reported by unicode.IsDigit.
+//
+// Slices that are equal in natural order, such as "file02" and "file2", are
+// ordered by the number of leading zeros and then rune by rune, so the result
+// is only zero when a and b are identical.
+//
+// The result is -1 if a < b, 0 if a == b and +1 if a > b.
+func CompareNatural(a []rune, b []rune) int {
+	return compareNatural(a, b, identityRune)
+}
+
+// CompareNaturalFold is like CompareNatural but uses Unicode case-folding to
+// compare runes that are not digits. The result is zero when a and b are equal
+// under EqualFold.
+func CompareNaturalFold(a []rune, b []rune) int {
+	return compareNatural(a, b, foldRune)
+}
+
+// SortNatural sorts s in place in natural order, as defined by CompareNatural.
+//
+// Example:
+//
+//	s := [][]rune{[]rune("file10"), []rune("file2"), []rune("file1")}
+//	SortNatural(s) // s will be [file1 file2 file10]
+func SortNatural(s [][]rune) {
+	slices.SortStableFunc(s, CompareNatural)
+}
+
+// SortNaturalFold sorts s in place in natural order, as defined by
+// CompareNaturalFold. Slices that are equal under EqualFold keep their
+// original order.
+func SortNaturalFold(s [][]rune) {
+	slices.SortStableFunc(s, CompareNaturalFold)
+}
+
+// compareNatural compares a and b in natural order after mapping the runes that
+// are not digits through key. Numbers with the same value are ordered by their
+// spelling only when nothing else differs.
+func compareNatural(a, b []rune, key func(r rune) rune) int {
+	i := 0
+	j := 0
+	tie := 0
+	for i < len(a) && j < len(b) {
+		if unicode.IsDigit(a[i]) && unicode.IsDigit(b[j]) {
+			si := i
+			sj := j
+			for i < len(a) && unicode.IsDigit(a[i]) {
+				i++
+			}
+
+			for j < len(b) && unicode.IsDigit(b[j]) {
+				j++
+			}
+
+			if c := compareDigits(a[si:i], b[sj:j]); c != 0 {
+				return c
+			}
+
+			if tie == 0 {
+				// fewer leading zeros first, then the digits themselves.
+				if c := (i - si) - (j - sj); c != 0 {
+					tie = sign(c)
+				} else if c := slices.Compare(a[si:i], b[sj:j]); c != 0 {
+					tie = c
+				}
+			}
+
+			continue
+		}
+
+		x := key(a[i])
+		y := key(b[j])
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+
+		i++
+		j++
+	}
+
+	switch {
+	case len(a)-i < len(b)-j:
+		return -1
+	case len(a)-i > len(b)-j:
+		return 1
+	}
+
+	return tie
+}
+
+// compareDigits compares two runs of decimal digits by numeric value.
+func compareDigits(a, b []rune) int {
+	for len(a) > 1 && digitValue(a[0]) == 0 {
+		a = a[1:]
+	}
+
+	for len(b) > 1 && digitValue(b[0]) == 0 {
+		b = b[1:]
+	}
+
+	if len(a) != len(b) {
+		return sign(len(a) - len(b))
+	}
+
+	for k := range a {
+		if c := digitValue(a[k]) - digitValue(b[k]); c != 0 {
+			return sign(c)
+		}
+	}
+
+	return 0
+}
+
+// digitValue returns the value of the decimal digit r. Unicode assigns decimal
+// digits in contiguous runs starting with zero, so the value is the distance
+// from the start of the run modulo ten.
+func digitValue(r rune) int {
+	if r >= '0' && r <= '9' {
+		return int(r - '0')
+	}
+
+	start := r
+	for unicode.IsDigit(start - 1) {
+		start--
+	}
+
+	return int(r-start) % 10
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+
+	return 0
+}
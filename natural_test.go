@@ -0,0 +1,50 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func toStrings(s [][]rune) []string {
+	r := make([]string, len(s))
+	for i, v := range s {
+		r[i] = string(v)
+	}
+
+	return r
+}
+
+func TestCompareNatural(t *testing.T) {
+	assert.Equal(t, -1, runes.CompareNatural([]rune("file2"), []rune("file10")))
+	assert.Equal(t, 1, runes.CompareNatural([]rune("file10"), []rune("file2")))
+	assert.Equal(t, 0, runes.CompareNatural([]rune("file10"), []rune("file10")))
+	assert.Equal(t, -1, runes.CompareNatural([]rune("file"), []rune("file1")))
+	assert.Equal(t, -1, runes.CompareNatural([]rune("file2"), []rune("file02")))
+	assert.Equal(t, 1, runes.CompareNatural([]rune("file02"), []rune("file2")))
+	assert.Equal(t, -1, runes.CompareNatural([]rune("File3"), []rune("file2")))
+	assert.Equal(t, -1, runes.CompareNatural([]rune("v1.9.0"), []rune("v1.10.0")))
+	assert.Equal(t, 1, runes.CompareNatural([]rune("a99999999999999999999999"), []rune("a9999999999999999999999")))
+	// Arabic-Indic and fullwidth digits compare by value.
+	assert.Equal(t, -1, runes.CompareNatural([]rune("item٢"), []rune("item١٠")))
+	assert.Equal(t, -1, runes.CompareNatural([]rune("item９"), []rune("item10")))
+	assert.Equal(t, 0, runes.CompareNatural(nil, []rune("")))
+}
+
+func TestCompareNaturalFold(t *testing.T) {
+	assert.Equal(t, 1, runes.CompareNaturalFold([]rune("File3"), []rune("file2")))
+	assert.Equal(t, 0, runes.CompareNaturalFold([]rune("FILE2"), []rune("file2")))
+	assert.Equal(t, -1, runes.CompareNaturalFold([]rune("FILE2"), []rune("file02")))
+	assert.Equal(t, -1, runes.CompareNaturalFold([]rune("a"), []rune("B")))
+}
+
+func TestSortNatural(t *testing.T) {
+	s := [][]rune{[]rune("file10"), []rune("File3"), []rune("file2"), []rune("file1"), []rune("file02")}
+	runes.SortNatural(s)
+	assert.Equal(t, []string{"File3", "file1", "file2", "file02", "file10"}, toStrings(s))
+
+	s = [][]rune{[]rune("file10"), []rune("FILE2"), []rune("File3"), []rune("file2"), []rune("file1")}
+	runes.SortNaturalFold(s)
+	assert.Equal(t, []string{"file1", "FILE2", "file2", "File3", "file10"}, toStrings(s))
+}
@@ -3,6 +3,7 @@ package xrunes
 import (
 	"slices"
 	"unicode"
+	"unicode/utf8"
 )
 
 // Contains checks if the slice of runes `r` is present within the slice of runes `s`.
@@ -338,11 +339,51 @@ func IsEmptySpace(s []rune) bool {
 	return IsSpace(s)
 }
 
+// equalFoldRune reports whether x and y belong to the same simple case-folding
+// orbit. Comparing the canonical members of both orbits, rather than a single
+// folding step, keeps the relation transitive for orbits with more than two
+// runes such as k, K and the Kelvin sign.
 func equalFoldRune(x, y rune) bool {
-	xx := unicode.SimpleFold(x)
-	if xx == y {
+	return foldRune(x) == foldRune(y)
+}
+
+// equalRuneFold reports whether x and y are the same rune or, when x is a
+// letter, equal under simple Unicode case-folding. It mirrors the per-rune
+// comparison performed by EqualFold.
+func equalRuneFold(x, y rune) bool {
+	if x == y {
 		return true
 	}
-	yy := unicode.SimpleFold(y)
-	return yy == x
+
+	return unicode.IsLetter(x) && equalFoldRune(x, y)
+}
+
+func equalRune(x, y rune) bool {
+	return x == y
+}
+
+// foldRune returns the smallest letter in the simple case-folding orbit of r,
+// which is used as a canonical key for fold-insensitive lookups. Runes that
+// are not letters are returned unchanged, matching EqualFold.
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+
+		return r
+	}
+
+	if !unicode.IsLetter(r) {
+		return r
+	}
+
+	m := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < m && unicode.IsLetter(f) {
+			m = f
+		}
+	}
+
+	return m
 }
@@ -17,6 +17,26 @@ func TestEqualFold(t *testing.T) {
 	assert.Equal(t, runes.Equal([]rune("test"), []rune(" test")), false)
 }
 
+func TestEqualFoldOrbits(t *testing.T) {
+	// every member of a SimpleFold orbit folds to the same rune, even when
+	// the two are more than one step apart.
+	orbits := [][]rune{
+		{'θ', 'ϑ', 'Θ', 'ϴ'},
+		{'k', 'K', '\u212A'},
+	}
+
+	for _, orbit := range orbits {
+		for _, a := range orbit {
+			for _, b := range orbit {
+				assert.True(t, runes.EqualFold([]rune{a}, []rune{b}), "%q %q", a, b)
+			}
+		}
+	}
+
+	assert.False(t, runes.EqualFold([]rune("θ"), []rune("k")))
+	assert.True(t, runes.EqualFold([]rune("\u212Aelvin"), []rune("KELVIN")))
+}
+
 func TestEqual(t *testing.T) {
 	assert.Equal(t, runes.Equal([]rune("test"), []rune("TEST")), false)
 	assert.Equal(t, runes.Equal([]rune("test"), []rune("Test")), false)
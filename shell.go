@@ -0,0 +1,184 @@
+package xrunes
+
+import "fmt"
+
+// ShellSyntaxError describes a command line that ShellSplit cannot parse.
+type ShellSyntaxError struct {
+	// Offset is the index of the rune where the error was found, such as the
+	// opening quote of an unterminated string.
+	Offset int
+	// Msg describes the error.
+	Msg string
+}
+
+func (e *ShellSyntaxError) Error() string {
+	return fmt.Sprintf("xrunes: %s at offset %d", e.Msg, e.Offset)
+}
+
+// ShellQuote quotes s so that a POSIX shell reads it back as a single word.
+// Words made only of ASCII letters, ASCII digits and the runes @%+=:,./-_
+// are returned as is, as Python's shlex.quote does, since the shell may not
+// treat other letters as word runes in every locale. Other words, including
+// the empty word, are enclosed in single quotes. Since a single quote cannot
+// appear inside them, each one is written as an escaped quote between the
+// quoted runs before and after it, with no empty run at either end.
+//
+// Example:
+//
+//	ShellQuote([]rune("file.txt"))    // file.txt
+//	ShellQuote([]rune("my file.txt")) // 'my file.txt'
+//	ShellQuote([]rune("it's"))        // 'it'\''s'
+//	ShellQuote([]rune("'a"))          // \''a'
+//	ShellQuote([]rune(""))            // ''
+func ShellQuote(s []rune) []rune {
+	if len(s) == 0 {
+		return []rune("''")
+	}
+
+	safe := true
+	for _, r := range s {
+		if !isShellSafe(r) {
+			safe = false
+			break
+		}
+	}
+
+	if safe {
+		return s
+	}
+
+	sb := make([]rune, 0, len(s)+2)
+	quoted := false
+	for _, r := range s {
+		if r == '\'' {
+			if quoted {
+				sb = append(sb, '\'')
+				quoted = false
+			}
+
+			sb = append(sb, '\\', '\'')
+			continue
+		}
+
+		if !quoted {
+			sb = append(sb, '\'')
+			quoted = true
+		}
+
+		sb = append(sb, r)
+	}
+
+	if quoted {
+		sb = append(sb, '\'')
+	}
+
+	return sb
+}
+
+func isShellSafe(r rune) bool {
+	switch r {
+	case '@', '%', '+', '=', ':', ',', '.', '/', '-', '_':
+		return true
+	}
+
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// ShellSplit splits a command line into words as a POSIX shell does, without
+// performing any expansion: words are separated by unquoted blanks and
+// newlines, single quotes preserve every rune up to the closing quote, double
+// quotes preserve every rune except a backslash before $, `, ", \ or a
+// newline, and an unquoted backslash preserves the next rune. A backslash
+// followed by a newline joins lines. A '#' that starts a word begins a comment
+// running to the end of the line. $ and ` are kept literally.
+//
+// It returns a *ShellSyntaxError for an unterminated quote or a trailing
+// backslash.
+//
+// Example:
+//
+//	ShellSplit([]rune(`git commit -m "fix: it's done" # comment`))
+//	// ["git" "commit" "-m" "fix: it's done"]
+func ShellSplit(s []rune) ([][]rune, error) {
+	words := make([][]rune, 0)
+	word := make([]rune, 0)
+	// inWord is true once a word has started, even if it is still empty as
+	// with ''.
+	inWord := false
+	for i := 0; i < len(s); i++ {
+		r := s[i]
+		switch {
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word)
+				word = make([]rune, 0)
+				inWord = false
+			}
+		case r == '#' && !inWord:
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+		case r == '\\':
+			if i+1 == len(s) {
+				return nil, &ShellSyntaxError{Offset: i, Msg: "trailing backslash"}
+			}
+
+			i++
+			if s[i] == '\n' {
+				continue
+			}
+
+			word = append(word, s[i])
+			inWord = true
+		case r == '\'':
+			start := i
+			i++
+			for i < len(s) && s[i] != '\'' {
+				i++
+			}
+
+			if i == len(s) {
+				return nil, &ShellSyntaxError{Offset: start, Msg: "unterminated single quote"}
+			}
+
+			word = append(word, s[start+1:i]...)
+			inWord = true
+		case r == '"':
+			start := i
+			closed := false
+			for i++; i < len(s); i++ {
+				if s[i] == '"' {
+					closed = true
+					break
+				}
+
+				if s[i] == '\\' && i+1 < len(s) {
+					switch s[i+1] {
+					case '\n':
+						i++
+						continue
+					case '$', '`', '"', '\\':
+						i++
+					}
+				}
+
+				word = append(word, s[i])
+			}
+
+			if !closed {
+				return nil, &ShellSyntaxError{Offset: start, Msg: "unterminated double quote"}
+			}
+
+			inWord = true
+		default:
+			word = append(word, r)
+			inWord = true
+		}
+	}
+
+	if inWord {
+		words = append(words, word)
+	}
+
+	return words, nil
+}
@@ -0,0 +1,112 @@
+package xrunes_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "''"},
+		{"file.txt", "file.txt"},
+		{"--name=value", "--name=value"},
+		{"user@host:/srv/a,b+c%", "user@host:/srv/a,b+c%"},
+		{"crème", "'crème'"},
+		{"my file.txt", "'my file.txt'"},
+		{"it's", `'it'\''s'`},
+		{"'", `\'`},
+		{"''", `\'\'`},
+		{"'a", `\''a'`},
+		{"a'", `'a'\'`},
+		{"'a b'", `\''a b'\'`},
+		{"$HOME", "'$HOME'"},
+		{"a\nb", "'a\nb'"},
+		{"*.go", "'*.go'"},
+		{"~", "'~'"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, string(runes.ShellQuote([]rune(tt.input))), tt.input)
+	}
+}
+
+func TestShellSplit(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{"", []string{}},
+		{"   \t\n ", []string{}},
+		{"ls -la /tmp", []string{"ls", "-la", "/tmp"}},
+		{`git commit -m "fix: it's done" # comment`, []string{"git", "commit", "-m", "fix: it's done"}},
+		{`echo 'a "b" \c'`, []string{"echo", `a "b" \c`}},
+		{`echo "a \"b\" \c \\ \$x \` + "`" + `"`, []string{"echo", `a "b" \c \ $x ` + "`"}},
+		{`a\ b c\\d \'e`, []string{"a b", `c\d`, "'e"}},
+		{`'' "" x''y`, []string{"", "", "xy"}},
+		{"one\\\ntwo", []string{"onetwo"}},
+		{"\"one\\\ntwo\"", []string{"onetwo"}},
+		{"a#b # c\nd", []string{"a#b", "d"}},
+		{"#only a comment", []string{}},
+		{`pre'quoted'"mixed"post`, []string{"prequotedmixedpost"}},
+		{"$HOME `date`", []string{"$HOME", "`date`"}},
+		{"日本 語", []string{"日本", "語"}},
+	}
+
+	for _, tt := range tests {
+		words, err := runes.ShellSplit([]rune(tt.input))
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.expected, toStrings(words), tt.input)
+	}
+}
+
+func TestShellSplitErrors(t *testing.T) {
+	tests := []struct {
+		input string
+		err   string
+	}{
+		{`echo 'unterminated`, "xrunes: unterminated single quote at offset 5"},
+		{`echo "a" "b`, "xrunes: unterminated double quote at offset 9"},
+		{`日本 "語`, "xrunes: unterminated double quote at offset 3"},
+		{`echo "trailing\`, "xrunes: unterminated double quote at offset 5"},
+		{`echo trailing\`, "xrunes: trailing backslash at offset 13"},
+	}
+
+	for _, tt := range tests {
+		_, err := runes.ShellSplit([]rune(tt.input))
+		var syntaxErr *runes.ShellSyntaxError
+		require.ErrorAs(t, err, &syntaxErr, tt.input)
+		assert.EqualError(t, err, tt.err, tt.input)
+	}
+}
+
+func TestShellQuoteRoundTrip(t *testing.T) {
+	alphabet := []rune("ab '\"\\$`#*?~\n\t=-_é日")
+	rng := rand.New(rand.NewPCG(3, 4))
+	for range 1000 {
+		args := make([][]rune, 1+rng.IntN(4))
+		line := make([]rune, 0)
+		for i := range args {
+			args[i] = make([]rune, rng.IntN(8))
+			for j := range args[i] {
+				args[i][j] = alphabet[rng.IntN(len(alphabet))]
+			}
+
+			if i > 0 {
+				line = append(line, ' ')
+			}
+
+			line = append(line, runes.ShellQuote(args[i])...)
+		}
+
+		words, err := runes.ShellSplit(line)
+		require.NoError(t, err, string(line))
+		assert.Equal(t, toStrings(args), toStrings(words), string(line))
+	}
+}
@@ -0,0 +1,285 @@
+package xrunes
+
+import (
+	"math"
+	"unicode"
+)
+
+// SimilarityParams defines the parameters used by the similarity functions.
+type SimilarityParams struct {
+	// Fold compares runes using Unicode case-folding, the same way EqualFold does.
+	Fold bool
+	// Normalize collapses runs of spaces, underscores, hyphens and punctuation into
+	// a single space and trims them from both ends before comparing, so that
+	// "user_name" and "user name" are considered identical. Combined with Fold,
+	// "user_name" and "User Name" are too.
+	Normalize bool
+	// Q is the gram size used by Dice, Jaccard and Cosine. It defaults to 2.
+	Q int
+}
+
+// SimilarityOption is a function type that modifies the options for SimilarityParams.
+type SimilarityOption func(params *SimilarityParams)
+
+// SimilarityFold sets the Fold field of the given SimilarityParams to true.
+func SimilarityFold(params *SimilarityParams) {
+	params.Fold = true
+}
+
+// SimilarityNormalize sets the Normalize field of the given SimilarityParams to true.
+func SimilarityNormalize(params *SimilarityParams) {
+	params.Normalize = true
+}
+
+// QGram returns a SimilarityOption that sets the gram size used by Dice,
+// Jaccard and Cosine. Values lower than 1 are ignored.
+func QGram(q int) SimilarityOption {
+	return func(params *SimilarityParams) {
+		if q > 0 {
+			params.Q = q
+		}
+	}
+}
+
+// Jaro returns the Jaro similarity of a and b, a value in [0, 1] where 1 means
+// the slices are identical and 0 means they have no runes in common.
+//
+// Example:
+//
+//	s := Jaro([]rune("MARTHA"), []rune("MARHTA")) // s will be about 0.944
+func Jaro(a []rune, b []rune, options ...SimilarityOption) float64 {
+	params := newSimilarityParams(options)
+	a = params.prepare(a)
+	b = params.prepare(b)
+
+	return jaro(a, b)
+}
+
+// JaroWinkler returns the Jaro–Winkler similarity of a and b, a value in [0, 1].
+// It boosts the Jaro similarity of slices that share a common prefix of up to
+// four runes, using the standard scaling factor of 0.1.
+//
+// Example:
+//
+//	s := JaroWinkler([]rune("MARTHA"), []rune("MARHTA")) // s will be about 0.961
+func JaroWinkler(a []rune, b []rune, options ...SimilarityOption) float64 {
+	params := newSimilarityParams(options)
+	a = params.prepare(a)
+	b = params.prepare(b)
+
+	j := jaro(a, b)
+	l := 0
+	for l < 4 && l < len(a) && l < len(b) && a[l] == b[l] {
+		l++
+	}
+
+	return j + float64(l)*0.1*(1-j)
+}
+
+// Dice returns the Sørensen–Dice coefficient of the q-grams of a and b,
+// a value in [0, 1]. The gram size defaults to 2 and can be set with QGram.
+//
+// Example:
+//
+//	s := Dice([]rune("night"), []rune("nacht")) // s will be 0.25
+func Dice(a []rune, b []rune, options ...SimilarityOption) float64 {
+	params := newSimilarityParams(options)
+	x, y, done, v := params.grams(a, b)
+	if done {
+		return v
+	}
+
+	total := 0
+	common := 0
+	for g, c := range x {
+		total += c
+		common += min(c, y[g])
+	}
+
+	for _, c := range y {
+		total += c
+	}
+
+	return 2 * float64(common) / float64(total)
+}
+
+// Jaccard returns the Jaccard index of the q-gram multisets of a and b,
+// a value in [0, 1]. The gram size defaults to 2 and can be set with QGram.
+//
+// Example:
+//
+//	s := Jaccard([]rune("night"), []rune("nacht")) // s will be 1/7
+func Jaccard(a []rune, b []rune, options ...SimilarityOption) float64 {
+	params := newSimilarityParams(options)
+	x, y, done, v := params.grams(a, b)
+	if done {
+		return v
+	}
+
+	inter := 0
+	union := 0
+	for g, c := range x {
+		inter += min(c, y[g])
+		union += max(c, y[g])
+	}
+
+	for g, c := range y {
+		if _, ok := x[g]; !ok {
+			union += c
+		}
+	}
+
+	return float64(inter) / float64(union)
+}
+
+// Cosine returns the cosine similarity of the q-gram frequency vectors of a and b,
+// a value in [0, 1]. The gram size defaults to 2 and can be set with QGram.
+//
+// Example:
+//
+//	s := Cosine([]rune("night"), []rune("nacht")) // s will be 0.25
+func Cosine(a []rune, b []rune, options ...SimilarityOption) float64 {
+	params := newSimilarityParams(options)
+	x, y, done, v := params.grams(a, b)
+	if done {
+		return v
+	}
+
+	dot := 0.0
+	nx := 0.0
+	ny := 0.0
+	for g, c := range x {
+		dot += float64(c * y[g])
+		nx += float64(c * c)
+	}
+
+	for _, c := range y {
+		ny += float64(c * c)
+	}
+
+	return min(1, dot/(math.Sqrt(nx)*math.Sqrt(ny)))
+}
+
+func newSimilarityParams(options []SimilarityOption) *SimilarityParams {
+	params := &SimilarityParams{Q: 2}
+	for _, option := range options {
+		option(params)
+	}
+
+	return params
+}
+
+// prepare applies the normalization and folding options to s. It never
+// modifies s in place.
+func (params *SimilarityParams) prepare(s []rune) []rune {
+	if !params.Fold && !params.Normalize {
+		return s
+	}
+
+	sb := make([]rune, 0, len(s))
+	for _, r := range s {
+		if params.Normalize && (r == '_' || r == '-' || unicode.IsSpace(r) || unicode.IsPunct(r)) {
+			if len(sb) > 0 && sb[len(sb)-1] != ' ' {
+				sb = append(sb, ' ')
+			}
+
+			continue
+		}
+
+		if params.Fold {
+			r = foldRune(r)
+		}
+
+		sb = append(sb, r)
+	}
+
+	if len(sb) > 0 && sb[len(sb)-1] == ' ' {
+		sb = sb[:len(sb)-1]
+	}
+
+	return sb
+}
+
+// grams returns the q-gram counts of a and b. When either slice is too short
+// to contain a single gram, done is true and v holds the final similarity.
+func (params *SimilarityParams) grams(a, b []rune) (x, y map[string]int, done bool, v float64) {
+	a = params.prepare(a)
+	b = params.prepare(b)
+	q := params.Q
+	if len(a) < q || len(b) < q {
+		if Equal(a, b) {
+			return nil, nil, true, 1
+		}
+
+		return nil, nil, true, 0
+	}
+
+	return qgrams(a, q), qgrams(b, q), false, 0
+}
+
+func qgrams(s []rune, q int) map[string]int {
+	m := make(map[string]int, len(s)-q+1)
+	for i := 0; i+q <= len(s); i++ {
+		m[string(s[i:i+q])]++
+	}
+
+	return m
+}
+
+func jaro(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+
+	am := make([]bool, len(a))
+	bm := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		lo := max(0, i-window)
+		hi := min(len(b), i+window+1)
+		for j := lo; j < hi; j++ {
+			if bm[j] || a[i] != b[j] {
+				continue
+			}
+
+			am[i] = true
+			bm[j] = true
+			matches++
+			break
+		}
+	}
+
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions := 0
+	j := 0
+	for i := range a {
+		if !am[i] {
+			continue
+		}
+
+		for !bm[j] {
+			j++
+		}
+
+		if a[i] != b[j] {
+			transpositions++
+		}
+
+		j++
+	}
+
+	m := float64(matches)
+	return (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions/2))/m) / 3
+}
@@ -0,0 +1,69 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJaro(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"", "", 1},
+		{"abc", "", 0},
+		{"MARTHA", "MARHTA", 0.944444},
+		{"DWAYNE", "DUANE", 0.822222},
+		{"DIXON", "DICKSONX", 0.766667},
+		{"CRATE", "TRACE", 0.733333},
+		{"abc", "xyz", 0},
+		{"same", "same", 1},
+	}
+
+	for _, tt := range tests {
+		assert.InDelta(t, tt.expected, runes.Jaro([]rune(tt.a), []rune(tt.b)), 1e-6, "%s/%s", tt.a, tt.b)
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"MARTHA", "MARHTA", 0.961111},
+		{"DWAYNE", "DUANE", 0.84},
+		{"DIXON", "DICKSONX", 0.813333},
+		{"TRATE", "TRACE", 0.906667},
+	}
+
+	for _, tt := range tests {
+		assert.InDelta(t, tt.expected, runes.JaroWinkler([]rune(tt.a), []rune(tt.b)), 1e-6, "%s/%s", tt.a, tt.b)
+	}
+
+	assert.InDelta(t, 0.961111, runes.JaroWinkler([]rune("martha"), []rune("MARHTA"), runes.SimilarityFold), 1e-6)
+	assert.Less(t, runes.JaroWinkler([]rune("martha"), []rune("MARHTA")), 0.5)
+}
+
+func TestDice(t *testing.T) {
+	assert.InDelta(t, 0.25, runes.Dice([]rune("night"), []rune("nacht")), 1e-9)
+	assert.InDelta(t, 1, runes.Dice([]rune("test"), []rune("test")), 1e-9)
+	assert.InDelta(t, 0, runes.Dice([]rune("ab"), []rune("cd")), 1e-9)
+	assert.InDelta(t, 1, runes.Dice([]rune("a"), []rune("a")), 1e-9)
+	assert.InDelta(t, 0, runes.Dice([]rune("a"), []rune("b")), 1e-9)
+	assert.InDelta(t, 0.6, runes.Dice([]rune("night"), []rune("nacht"), runes.QGram(1)), 1e-9)
+	assert.InDelta(t, 1, runes.Dice([]rune("User_Name"), []rune("user name"), runes.SimilarityFold, runes.SimilarityNormalize), 1e-9)
+}
+
+func TestJaccard(t *testing.T) {
+	assert.InDelta(t, 1.0/7.0, runes.Jaccard([]rune("night"), []rune("nacht")), 1e-9)
+	assert.InDelta(t, 1, runes.Jaccard([]rune("NIGHT"), []rune("night"), runes.SimilarityFold), 1e-9)
+	assert.InDelta(t, 0.0, runes.Jaccard([]rune("NIGHT"), []rune("night")), 1e-9)
+}
+
+func TestCosine(t *testing.T) {
+	assert.InDelta(t, 0.25, runes.Cosine([]rune("night"), []rune("nacht")), 1e-9)
+	assert.InDelta(t, 1, runes.Cosine([]rune("abab"), []rune("abab")), 1e-9)
+	assert.InDelta(t, 0.948683, runes.Cosine([]rune("aab"), []rune("ab"), runes.QGram(1)), 1e-6)
+}
@@ -0,0 +1,162 @@
+package xrunes
+
+import (
+	"slices"
+	"unicode"
+)
+
+//go:generate go run gen.go -ucd UnicodeData.txt
+
+// SlugParams defines the parameters used by Slugify.
+type SlugParams struct {
+	// Separator is the rune placed between words. It defaults to '-'.
+	Separator rune
+	// MaxLength is the maximum number of runes of the slug. When the slug is
+	// longer it is cut at the last separator that fits, or at MaxLength when a
+	// single word is longer. Zero means no limit.
+	MaxLength int
+	// German transliterates ä, ö and ü as ae, oe and ue instead of stripping
+	// their diaeresis.
+	German bool
+}
+
+// SlugOption is a function type that modifies the options for SlugParams.
+type SlugOption func(params *SlugParams)
+
+// SlugSeparator returns a SlugOption that sets the rune placed between words.
+func SlugSeparator(separator rune) SlugOption {
+	return func(params *SlugParams) {
+		params.Separator = separator
+	}
+}
+
+// SlugMaxLength returns a SlugOption that limits the slug to n runes.
+func SlugMaxLength(n int) SlugOption {
+	return func(params *SlugParams) {
+		params.MaxLength = n
+	}
+}
+
+// SlugGerman sets the German field of the given SlugParams to true.
+func SlugGerman(params *SlugParams) {
+	params.German = true
+}
+
+var germanTranslit = map[rune][]rune{
+	'ä': []rune("ae"), 'ö': []rune("oe"), 'ü': []rune("ue"),
+	'Ä': []rune("Ae"), 'Ö': []rune("Oe"), 'Ü': []rune("Ue"),
+}
+
+// Transliterate returns a copy of runes where every rune outside of ASCII that
+// has a known transliteration is replaced by it. Diacritics are stripped using
+// the Unicode decompositions, so "é" becomes "e" and "ﬁ" becomes "fi", and the
+// Greek and Cyrillic alphabets as well as Latin letters such as "ß", "æ" and
+// "ø" are transliterated from generated tables. Runes without a
+// transliteration are kept as is.
+//
+// Example:
+//
+//	s := Transliterate([]rune("Crème Brûlée")) // s will be "Creme Brulee"
+func Transliterate(runes []rune) []rune {
+	return transliterate(runes, false)
+}
+
+// Slugify converts runes into a URL-safe slug made of lowercase ASCII letters
+// and digits. The runes are transliterated with Transliterate, then every run
+// of other runes is collapsed into a single separator, like Dasherize does,
+// and separators are trimmed from both ends. Runes that cannot be
+// transliterated to ASCII are dropped.
+//
+// Parameters:
+//
+//	runes: A slice of runes to be transformed.
+//	options: Variadic SlugOption to customize the separator, maximum length
+//	and transliteration rules.
+//
+// Returns:
+//
+//	A new slice of runes with the slug.
+//
+// Example:
+//
+//	s := Slugify([]rune("Crème Brûlée!")) // s will be "creme-brulee"
+func Slugify(runes []rune, options ...SlugOption) []rune {
+	params := &SlugParams{Separator: '-'}
+	for _, option := range options {
+		option(params)
+	}
+
+	sb := make([]rune, 0, len(runes))
+	pending := false
+	for _, r := range transliterate(runes, params.German) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if pending && len(sb) > 0 {
+				sb = append(sb, params.Separator)
+			}
+
+			pending = false
+			sb = append(sb, unicode.ToLower(r))
+			continue
+		}
+
+		if r >= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsNumber(r) || unicode.Is(unicode.Mn, r)) {
+			// untransliterated letters and marks are dropped without
+			// splitting the word they belong to.
+			continue
+		}
+
+		pending = true
+	}
+
+	if params.MaxLength > 0 && len(sb) > params.MaxLength {
+		cut := params.MaxLength
+		if sb[cut] != params.Separator {
+			if i := lastIndexRune(sb[:cut], params.Separator); i > 0 {
+				cut = i
+			}
+		}
+
+		sb = TrimRight(sb[:cut], []rune{params.Separator})
+	}
+
+	return sb
+}
+
+func lastIndexRune(s []rune, r rune) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if s[i] == r {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func transliterate(runes []rune, german bool) []rune {
+	sb := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		if r < unicode.MaxASCII {
+			sb = append(sb, r)
+			continue
+		}
+
+		if german {
+			if s, ok := germanTranslit[r]; ok {
+				sb = append(sb, s...)
+				continue
+			}
+		}
+
+		if i, ok := slices.BinarySearch(translitRunes[:], r); ok {
+			for _, c := range translitValues[i] {
+				sb = append(sb, c)
+			}
+
+			continue
+		}
+
+		sb = append(sb, r)
+	}
+
+	return sb
+}
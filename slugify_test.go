@@ -0,0 +1,55 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransliterate(t *testing.T) {
+	tests := map[string]string{
+		"Crème Brûlée":    "Creme Brulee",
+		"Straße":          "Strasse",
+		"Æsir Ørsted":     "AEsir Orsted",
+		"ﬁle №1":          "file No1",
+		"Ａｂｃ":             "Abc",
+		"Αθήνα":           "Athina",
+		"Москва":          "Moskva",
+		"Щука й ёж":       "Shchuka y yozh",
+		"Łódź":            "Lodz",
+		"plain ascii 123": "plain ascii 123",
+		"東京":              "東京",
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, string(runes.Transliterate([]rune(input))), input)
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		input    string
+		options  []runes.SlugOption
+		expected string
+	}{
+		{"Crème Brûlée", nil, "creme-brulee"},
+		{"  Hello,   World!  ", nil, "hello-world"},
+		{"user_name--v2.0", nil, "user-name-v2-0"},
+		{"Über Größe", nil, "uber-grosse"},
+		{"Über Größe", []runes.SlugOption{runes.SlugGerman}, "ueber-groesse"},
+		{"Привет, мир", nil, "privet-mir"},
+		{"Καλημέρα κόσμε", nil, "kalimera-kosme"},
+		{"東京 Tower", nil, "tower"},
+		{"Crème Brûlée", []runes.SlugOption{runes.SlugSeparator('_')}, "creme_brulee"},
+		{"the quick brown fox", []runes.SlugOption{runes.SlugMaxLength(12)}, "the-quick"},
+		{"the quick brown fox", []runes.SlugOption{runes.SlugMaxLength(9)}, "the-quick"},
+		{"supercalifragilistic", []runes.SlugOption{runes.SlugMaxLength(5)}, "super"},
+		{"", nil, ""},
+		{"!!!", nil, ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, string(runes.Slugify([]rune(tt.input), tt.options...)), tt.input)
+	}
+}
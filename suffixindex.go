@@ -0,0 +1,272 @@
+package xrunes
+
+import (
+	"slices"
+	"sort"
+)
+
+// SuffixIndex is a suffix array with its LCP array built once over a corpus
+// of runes. It answers substring queries such as Contains, Count and Lookup
+// in O(m log n) time for a query of length m over a corpus of length n, which
+// is much faster than calling Index repeatedly against the same corpus.
+// It is a plain suffix array, not a compressed FM-index, and keeps the corpus
+// plus two ints per rune in memory.
+//
+// The empty query matches at every offset from 0 to n inclusive, the same as
+// Index and strings.Count treat an empty substring.
+//
+// A SuffixIndex is immutable once built and is safe for concurrent use.
+type SuffixIndex struct {
+	corpus []rune
+	text   []rune
+	fold   bool
+	sa     []int
+	lcp    []int
+}
+
+// NewSuffixIndex builds a SuffixIndex over corpus by prefix doubling, which
+// takes O(n log² n) time. The corpus is referenced, not copied, and must not be
+// modified while the index is in use.
+//
+// Example:
+//
+//	idx := NewSuffixIndex([]rune("banana"))
+//	n := idx.Count([]rune("ana")) // n will be 2
+func NewSuffixIndex(corpus []rune) *SuffixIndex {
+	return newSuffixIndex(corpus, false)
+}
+
+// NewSuffixIndexFold builds a SuffixIndex over a case-folded view of corpus,
+// so that every query compares runes the same way EqualFold does.
+func NewSuffixIndexFold(corpus []rune) *SuffixIndex {
+	return newSuffixIndex(corpus, true)
+}
+
+func newSuffixIndex(corpus []rune, fold bool) *SuffixIndex {
+	text := corpus
+	if fold {
+		text = make([]rune, len(corpus))
+		for i, r := range corpus {
+			text[i] = foldRune(r)
+		}
+	}
+
+	sa := suffixArray(text)
+	return &SuffixIndex{
+		corpus: corpus,
+		text:   text,
+		fold:   fold,
+		sa:     sa,
+		lcp:    lcpArray(text, sa),
+	}
+}
+
+// Len returns the number of runes in the indexed corpus.
+func (idx *SuffixIndex) Len() int {
+	return len(idx.corpus)
+}
+
+// Contains reports whether q is present within the corpus. An empty q is
+// always present.
+func (idx *SuffixIndex) Contains(q []rune) bool {
+	if len(q) == 0 {
+		return true
+	}
+
+	lo, hi := idx.bounds(q)
+	return hi > lo
+}
+
+// Count returns the number of, possibly overlapping, occurrences of q in the
+// corpus. An empty q occurs at every position, including the end.
+func (idx *SuffixIndex) Count(q []rune) int {
+	if len(q) == 0 {
+		return len(idx.corpus) + 1
+	}
+
+	lo, hi := idx.bounds(q)
+	return hi - lo
+}
+
+// Lookup returns the indexes of every occurrence of q in the corpus, in
+// increasing order. An empty q occurs at every index from 0 to Len().
+func (idx *SuffixIndex) Lookup(q []rune) []int {
+	if len(q) == 0 {
+		positions := make([]int, len(idx.corpus)+1)
+		for i := range positions {
+			positions[i] = i
+		}
+
+		return positions
+	}
+
+	lo, hi := idx.bounds(q)
+	positions := slices.Clone(idx.sa[lo:hi])
+	slices.Sort(positions)
+
+	return positions
+}
+
+// Index returns the index of the first occurrence of q in the corpus, or -1 if
+// q is not present. It is equivalent to calling Index, or IndexFold for a
+// folded index, on the corpus.
+func (idx *SuffixIndex) Index(q []rune) int {
+	if len(q) == 0 {
+		return 0
+	}
+
+	lo, hi := idx.bounds(q)
+	if lo == hi {
+		return -1
+	}
+
+	return slices.Min(idx.sa[lo:hi])
+}
+
+// LongestRepeatedSubstring returns the longest run of runes that occurs at least
+// twice in the corpus, as a sub-slice of the corpus. Occurrences may overlap.
+// It returns an empty slice when no rune is repeated.
+//
+// Example:
+//
+//	s := NewSuffixIndex([]rune("banana")).LongestRepeatedSubstring() // s will be "ana"
+func (idx *SuffixIndex) LongestRepeatedSubstring() []rune {
+	best := 0
+	at := 0
+	for i, l := range idx.lcp {
+		if l > best || (l == best && l > 0 && idx.sa[i] < at) {
+			best = l
+			at = idx.sa[i]
+		}
+	}
+
+	return idx.corpus[at : at+best]
+}
+
+// bounds returns the range [lo, hi) of the suffix array whose suffixes start with q.
+func (idx *SuffixIndex) bounds(q []rune) (int, int) {
+	if idx.fold {
+		folded := make([]rune, len(q))
+		for i, r := range q {
+			folded[i] = foldRune(r)
+		}
+
+		q = folded
+	}
+
+	n := len(idx.sa)
+	lo := sort.Search(n, func(i int) bool {
+		return comparePrefix(idx.text[idx.sa[i]:], q) >= 0
+	})
+
+	hi := lo + sort.Search(n-lo, func(i int) bool {
+		return comparePrefix(idx.text[idx.sa[lo+i]:], q) > 0
+	})
+
+	return lo, hi
+}
+
+// comparePrefix compares the first len(q) runes of s with q, treating s as
+// smaller when it is a proper prefix of q.
+func comparePrefix(s, q []rune) int {
+	for i, r := range q {
+		if i == len(s) {
+			return -1
+		}
+
+		if s[i] != r {
+			if s[i] < r {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// suffixArray returns the suffix array of s built by prefix doubling.
+func suffixArray(s []rune) []int {
+	n := len(s)
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+	for i := range s {
+		sa[i] = i
+		rank[i] = int(s[i])
+	}
+
+	for k := 1; ; k <<= 1 {
+		key := func(i int) (int, int) {
+			second := -1
+			if i+k < n {
+				second = rank[i+k]
+			}
+
+			return rank[i], second
+		}
+
+		slices.SortFunc(sa, func(x, y int) int {
+			x1, x2 := key(x)
+			y1, y2 := key(y)
+			if x1 != y1 {
+				return x1 - y1
+			}
+
+			return x2 - y2
+		})
+
+		if n == 0 {
+			break
+		}
+
+		tmp[sa[0]] = 0
+		for i := 1; i < n; i++ {
+			a1, a2 := key(sa[i-1])
+			b1, b2 := key(sa[i])
+			tmp[sa[i]] = tmp[sa[i-1]]
+			if a1 != b1 || a2 != b2 {
+				tmp[sa[i]]++
+			}
+		}
+
+		copy(rank, tmp)
+		if rank[sa[n-1]] == n-1 {
+			break
+		}
+	}
+
+	return sa
+}
+
+// lcpArray returns, using Kasai's algorithm, the length of the longest common
+// prefix between each suffix in sa and the one before it.
+func lcpArray(s []rune, sa []int) []int {
+	n := len(s)
+	lcp := make([]int, n)
+	rank := make([]int, n)
+	for i, p := range sa {
+		rank[p] = i
+	}
+
+	h := 0
+	for i := 0; i < n; i++ {
+		if rank[i] == 0 {
+			h = 0
+			continue
+		}
+
+		j := sa[rank[i]-1]
+		for i+h < n && j+h < n && s[i+h] == s[j+h] {
+			h++
+		}
+
+		lcp[rank[i]] = h
+		if h > 0 {
+			h--
+		}
+	}
+
+	return lcp
+}
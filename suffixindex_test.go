@@ -0,0 +1,88 @@
+package xrunes_test
+
+import (
+	"math/rand"
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuffixIndex(t *testing.T) {
+	idx := runes.NewSuffixIndex([]rune("banana"))
+	assert.Equal(t, 6, idx.Len())
+	assert.True(t, idx.Contains([]rune("nan")))
+	assert.False(t, idx.Contains([]rune("nab")))
+	assert.False(t, idx.Contains([]rune("bananas")))
+	assert.Equal(t, 2, idx.Count([]rune("ana")))
+	assert.Equal(t, 3, idx.Count([]rune("a")))
+	assert.Equal(t, 7, idx.Count([]rune("")))
+	assert.Equal(t, []int{1, 3, 5}, idx.Lookup([]rune("a")))
+	assert.Empty(t, idx.Lookup([]rune("x")))
+	assert.Equal(t, 2, idx.Index([]rune("nan")))
+	assert.Equal(t, -1, idx.Index([]rune("BAN")))
+	assert.Equal(t, "ana", string(idx.LongestRepeatedSubstring()))
+}
+
+func TestSuffixIndexFold(t *testing.T) {
+	idx := runes.NewSuffixIndexFold([]rune("Hello HELLO hello"))
+	assert.Equal(t, 3, idx.Count([]rune("hello")))
+	assert.Equal(t, []int{0, 6, 12}, idx.Lookup([]rune("HeLLo")))
+	assert.Equal(t, 0, idx.Index([]rune("HELLO")))
+	assert.Equal(t, "Hello HELLO", string(idx.LongestRepeatedSubstring()))
+}
+
+func TestSuffixIndexEmpty(t *testing.T) {
+	idx := runes.NewSuffixIndex(nil)
+	assert.False(t, idx.Contains([]rune("a")))
+	assert.Equal(t, 0, idx.Count([]rune("a")))
+	assert.Empty(t, idx.LongestRepeatedSubstring())
+}
+
+func TestSuffixIndexEmptyQuery(t *testing.T) {
+	idx := runes.NewSuffixIndex([]rune("abc"))
+	assert.True(t, idx.Contains(nil))
+	assert.Equal(t, 4, idx.Count(nil))
+	assert.Equal(t, []int{0, 1, 2, 3}, idx.Lookup(nil))
+	assert.Equal(t, 0, idx.Index(nil))
+
+	empty := runes.NewSuffixIndex(nil)
+	assert.True(t, empty.Contains([]rune("")))
+	assert.Equal(t, 1, empty.Count([]rune("")))
+	assert.Equal(t, []int{0}, empty.Lookup([]rune("")))
+	assert.Equal(t, 0, empty.Index([]rune("")))
+}
+
+func TestSuffixIndexMatchesIndex(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	gen := func(n int) []rune {
+		r := make([]rune, n)
+		for i := range r {
+			r[i] = []rune("abAB")[rng.Intn(4)]
+		}
+		return r
+	}
+
+	for n := 0; n < 200; n++ {
+		corpus := gen(rng.Intn(40))
+		idx := runes.NewSuffixIndex(corpus)
+		fidx := runes.NewSuffixIndexFold(corpus)
+		for m := 0; m < 10; m++ {
+			q := gen(rng.Intn(5))
+			assert.Equal(t, runes.Index(corpus, q), idx.Index(q))
+			assert.Equal(t, runes.IndexFold(corpus, q), fidx.Index(q))
+			assert.Equal(t, runes.Contains(corpus, q), idx.Contains(q))
+			assert.Len(t, idx.Lookup(q), idx.Count(q))
+		}
+	}
+}
+
+func BenchmarkSuffixIndexContains(b *testing.B) {
+	corpus := longRunes(1, 100000)
+	idx := runes.NewSuffixIndex(corpus)
+	q := []rune("kappa alpha zeta")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		idx.Contains(q)
+	}
+}
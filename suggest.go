@@ -0,0 +1,189 @@
+package xrunes
+
+import "slices"
+
+// SuggesterParams defines the parameters used by a Suggester.
+type SuggesterParams struct {
+	// Fold compares candidates using Unicode case-folding, the same way EqualFold does.
+	Fold bool
+	// IgnoreCaseStyle compares candidates by their Underscore form, so that
+	// "user-name", "user_name" and "UserName" are all considered identical.
+	IgnoreCaseStyle bool
+}
+
+// SuggesterOption is a function type that modifies the options for SuggesterParams.
+type SuggesterOption func(params *SuggesterParams)
+
+// SuggesterFold sets the Fold field of the given SuggesterParams to true.
+func SuggesterFold(params *SuggesterParams) {
+	params.Fold = true
+}
+
+// SuggesterIgnoreCaseStyle sets the IgnoreCaseStyle field of the given
+// SuggesterParams to true.
+func SuggesterIgnoreCaseStyle(params *SuggesterParams) {
+	params.IgnoreCaseStyle = true
+}
+
+// Suggestion is a candidate returned by Suggester.Suggest.
+type Suggestion struct {
+	// Candidate is the candidate as it was added to the Suggester.
+	Candidate []rune
+	// Distance is the Levenshtein distance between the query and the candidate,
+	// after the Suggester options have been applied to both.
+	Distance int
+}
+
+// Suggester answers "did you mean" queries over a dictionary of candidates.
+// Candidates are indexed in a BK-tree keyed by Levenshtein distance, so that
+// a query only visits the part of the dictionary that can be within the
+// requested threshold.
+//
+// A Suggester is not safe for concurrent use while candidates are being added.
+type Suggester struct {
+	params SuggesterParams
+	root   *bkNode
+	seq    int
+}
+
+type bkNode struct {
+	key      []rune
+	entries  []bkEntry
+	children map[int]*bkNode
+}
+
+type bkEntry struct {
+	candidate []rune
+	seq       int
+}
+
+// NewSuggester creates a Suggester indexing the given candidates.
+//
+// Example:
+//
+//	s := NewSuggester([][]rune{[]rune("checkout"), []rune("cherry-pick")})
+//	found := s.Suggest([]rune("chekout"), 1, 2) // found[0].Candidate will be "checkout"
+func NewSuggester(candidates [][]rune, options ...SuggesterOption) *Suggester {
+	s := &Suggester{}
+	for _, option := range options {
+		option(&s.params)
+	}
+
+	for _, candidate := range candidates {
+		s.Add(candidate)
+	}
+
+	return s
+}
+
+// Add indexes candidate. Candidates that are identical once the Suggester
+// options are applied are all kept and returned together.
+func (s *Suggester) Add(candidate []rune) {
+	key := s.key(candidate)
+	entry := bkEntry{candidate: candidate, seq: s.seq}
+	s.seq++
+
+	if s.root == nil {
+		s.root = &bkNode{key: key, entries: []bkEntry{entry}}
+		return
+	}
+
+	node := s.root
+	for {
+		d := Levenshtein(key, node.key)
+		if d == 0 {
+			node.entries = append(node.entries, entry)
+			return
+		}
+
+		child, ok := node.children[d]
+		if !ok {
+			if node.children == nil {
+				node.children = make(map[int]*bkNode)
+			}
+
+			node.children[d] = &bkNode{key: key, entries: []bkEntry{entry}}
+			return
+		}
+
+		node = child
+	}
+}
+
+// Suggest returns up to k candidates whose distance to query is at most
+// maxDistance, closest first. Candidates at the same distance are returned in
+// the order they were added. A k lower than 1 returns every candidate within
+// the threshold.
+func (s *Suggester) Suggest(query []rune, k int, maxDistance int) []Suggestion {
+	type found struct {
+		entry    bkEntry
+		distance int
+	}
+
+	results := make([]found, 0)
+	if s.root == nil || maxDistance < 0 {
+		return []Suggestion{}
+	}
+
+	key := s.key(query)
+	stack := []*bkNode{s.root}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		d := Levenshtein(key, node.key)
+		if d <= maxDistance {
+			for _, entry := range node.entries {
+				results = append(results, found{entry: entry, distance: d})
+			}
+		}
+
+		for edge, child := range node.children {
+			if edge >= d-maxDistance && edge <= d+maxDistance {
+				stack = append(stack, child)
+			}
+		}
+	}
+
+	slices.SortFunc(results, func(x, y found) int {
+		if x.distance != y.distance {
+			return x.distance - y.distance
+		}
+
+		return x.entry.seq - y.entry.seq
+	})
+
+	if k > 0 && len(results) > k {
+		results = results[:k]
+	}
+
+	suggestions := make([]Suggestion, len(results))
+	for i, r := range results {
+		suggestions[i] = Suggestion{Candidate: r.entry.candidate, Distance: r.distance}
+	}
+
+	return suggestions
+}
+
+// Len returns the number of candidates indexed by the Suggester.
+func (s *Suggester) Len() int {
+	return s.seq
+}
+
+func (s *Suggester) key(candidate []rune) []rune {
+	key := candidate
+	if s.params.IgnoreCaseStyle {
+		key = Underscore(key)
+	}
+
+	if s.params.Fold {
+		folded := make([]rune, len(key))
+		for i, r := range key {
+			folded[i] = foldRune(r)
+		}
+
+		key = folded
+	}
+
+	return key
+}
@@ -0,0 +1,64 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func candidates(names ...string) [][]rune {
+	r := make([][]rune, len(names))
+	for i, name := range names {
+		r[i] = []rune(name)
+	}
+
+	return r
+}
+
+func suggested(suggestions []runes.Suggestion) []string {
+	names := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		names[i] = string(s.Candidate)
+	}
+
+	return names
+}
+
+func TestSuggester(t *testing.T) {
+	s := runes.NewSuggester(candidates("checkout", "cherry-pick", "commit", "clone", "config", "clean"))
+	assert.Equal(t, 6, s.Len())
+
+	found := s.Suggest([]rune("chekout"), 1, 2)
+	assert.Equal(t, []string{"checkout"}, suggested(found))
+	assert.Equal(t, 1, found[0].Distance)
+
+	assert.Equal(t, []string{"clean", "clone"}, suggested(s.Suggest([]rune("clen"), 0, 2)))
+	assert.Empty(t, s.Suggest([]rune("xyzzy"), 3, 2))
+	assert.Empty(t, s.Suggest([]rune("commit"), 3, -1))
+	assert.Empty(t, runes.NewSuggester(nil).Suggest([]rune("commit"), 3, 2))
+}
+
+func TestSuggesterFold(t *testing.T) {
+	s := runes.NewSuggester(candidates("Checkout", "Commit"))
+	assert.Empty(t, s.Suggest([]rune("CHECKOUT"), 1, 2))
+
+	s = runes.NewSuggester(candidates("Checkout", "Commit"), runes.SuggesterFold)
+	found := s.Suggest([]rune("CHECKOUT"), 1, 2)
+	assert.Equal(t, []string{"Checkout"}, suggested(found))
+	assert.Equal(t, 0, found[0].Distance)
+}
+
+func TestSuggesterIgnoreCaseStyle(t *testing.T) {
+	s := runes.NewSuggester(candidates("UserName", "UserId", "GroupName"), runes.SuggesterIgnoreCaseStyle)
+	found := s.Suggest([]rune("user-name"), 2, 1)
+	assert.Equal(t, []string{"UserName"}, suggested(found))
+	assert.Equal(t, 0, found[0].Distance)
+
+	assert.Equal(t, []string{"UserId"}, suggested(s.Suggest([]rune("user_ids"), 1, 1)))
+}
+
+func TestSuggesterDuplicates(t *testing.T) {
+	s := runes.NewSuggester(candidates("user-name", "user_name", "username"), runes.SuggesterIgnoreCaseStyle)
+	assert.Equal(t, []string{"user-name", "user_name", "username"}, suggested(s.Suggest([]rune("UserName"), 0, 1)))
+}
@@ -0,0 +1,22 @@
+// Package tmpl exposes the case transforms of xrunes, together with a few
+// layout and English inflection helpers, as template functions for
+// text/template and html/template.
+package tmpl
+
+import (
+	"text/template"
+
+	xrunes "github.com/jolt9dev/go-xrunes"
+)
+
+// FuncMap returns xrunes.FuncMap as a template.FuncMap, for text/template and
+// html/template, which share the same FuncMap type. See xrunes.FuncMap for
+// the functions.
+//
+// Example:
+//
+//	t := template.Must(template.New("").Funcs(tmpl.FuncMap()).Parse(
+//		`type {{ pascal .Name }} struct{} // table {{ snake .Name | pluralize }}`))
+func FuncMap() template.FuncMap {
+	return xrunes.FuncMap()
+}
@@ -0,0 +1,109 @@
+package tmpl_test
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/jolt9dev/go-xrunes/tmpl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func render(t *testing.T, text string, data any) string {
+	t.Helper()
+	tpl, err := template.New("test").Funcs(tmpl.FuncMap()).Parse(text)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, tpl.Execute(&sb, data))
+	return sb.String()
+}
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{`{{ snake "userName" }}`, "user_name"},
+		{`{{ kebab "userName" }}`, "user-name"},
+		{`{{ camel "user_name" }}`, "userName"},
+		{`{{ pascal "user_name" }}`, "UserName"},
+		{`{{ screamingSnake "maxConns" }}`, "MAX_CONNS"},
+		{`{{ title "user_name" }}`, "User Name"},
+		{`{{ title "HTTPServer" }}`, "Httpserver"},
+		{`{{ slug "Crème Brûlée!" }}`, "creme-brulee"},
+		{`{{ "a long sentence" | truncate 8 }}`, "a long …"},
+		{`{{ "short" | truncate 8 }}`, "short"},
+		{`{{ "日本語テキスト" | truncate 7 }}`, "日本語…"},
+		{`[{{ "id" | pad 6 }}]`, "[id    ]"},
+		{`[{{ "日本" | pad 6 }}]`, "[日本  ]"},
+		{`[{{ "toolong" | pad 3 }}]`, "[toolong]"},
+		{`{{ "first line\nsecond" | wrap 80 }}`, "first line\nsecond"},
+		{`{{ pluralize "category" }}`, "categories"},
+		{`{{ pluralize "day" }}`, "days"},
+		{`{{ pluralize "box" }}`, "boxes"},
+		{`{{ pluralize "Match" }}`, "Matches"},
+		{`{{ pluralize "Person" }}`, "People"},
+		{`{{ pluralize "order_item" }}`, "order_items"},
+		{`{{ pluralize "ORDER" }}`, "ORDERS"},
+		{`{{ pluralize "" }}`, ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, render(t, tt.text, nil), tt.text)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		text     string
+		n        int
+		expected string
+	}{
+		{"a long sentence to wrap", 10, "a long\nsentence\nto wrap"},
+		{"  - an indented item to wrap", 12, "  - an\n  indented\n  item to\n  wrap"},
+		{"\tfoo bar baz", 12, "\tfoo\n\tbar\n\tbaz"},
+		{"keep  two  spaces", 80, "keep  two  spaces"},
+		{"keep  two  spaces", 10, "keep  two\nspaces"},
+		{"first paragraph\n\n    second one here", 12, "first\nparagraph\n\n    second\n    one here"},
+		{"trailing  ", 80, "trailing  "},
+		{"averyveryverylongword here", 5, "averyveryverylongword\nhere"},
+		{"日本語 テキスト", 8, "日本語\nテキスト"},
+		{"", 10, ""},
+		{"\n", 10, "\n"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, render(t, `{{ wrap .N .Text }}`, map[string]any{"N": tt.n, "Text": tt.text}), tt.text)
+	}
+}
+
+func TestFuncMapGeneratedCode(t *testing.T) {
+	text := `{{ range . -}}
+// {{ pascal . }} is a row of the {{ snake . | pluralize }} table.
+type {{ pascal . }} struct {
+	{{ "ID" | pad 8 }} int64  ` + "`" + `json:"{{ camel . }}ID"` + "`" + `
+}
+{{ end }}`
+	assert.Equal(t, `// UserAccount is a row of the user_accounts table.
+type UserAccount struct {
+	ID       int64  `+"`"+`json:"userAccountID"`+"`"+`
+}
+// OrderCategory is a row of the order_categories table.
+type OrderCategory struct {
+	ID       int64  `+"`"+`json:"orderCategoryID"`+"`"+`
+}
+`, render(t, text, []string{"user account", "order-category"}))
+}
+
+func TestFuncMapHTML(t *testing.T) {
+	tpl, err := htmltemplate.New("test").Funcs(tmpl.FuncMap()).Parse(
+		`<a href="/{{ slug .Title }}" id="{{ kebab .ID }}">{{ title .Title }}</a>`)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, tpl.Execute(&sb, map[string]string{"Title": "Fish & Chips", "ID": "menuItem"}))
+	assert.Equal(t, `<a href="/fish-chips" id="menu-item">Fish Chips</a>`, sb.String())
+}
@@ -10,6 +10,10 @@ type HyphenMinusParams struct {
 	PreserveCase bool
 	// Screaming specifies whether the text should be transformed to uppercase.
 	Screaming bool
+	// SplitAcronyms ends an acronym before a following capitalized word, so
+	// that "HTTPServer" is split into "HTTP" and "Server" rather than kept as
+	// a single word.
+	SplitAcronyms bool
 }
 
 // HyphenMinusOption is a function type that modifies the options for HyphenMinusParams.
@@ -30,7 +34,22 @@ func Screaming(params *HyphenMinusParams) {
 //
 // Parameters:
 //   - params: A pointer to a HyphenMinusParams struct that will be modified.
+func PreserveCase(params *HyphenMinusParams) {
+	params.PreserveCase = true
+}
+
+// SplitAcronyms sets the SplitAcronyms field of the given HyphenMinusParams
+// to true, so that "HTTPServer" becomes "http_server" instead of "httpserver".
+func SplitAcronyms(params *HyphenMinusParams) {
+	params.SplitAcronyms = true
+}
+
+// Underscore converts a slice of runes into snake_case. It inserts underscores
+// between words and converts letters to lowercase by default.
+// Options can be provided to preserve the case or convert all letters to uppercase.
 //
+// Parameters:
+// - runes: A slice of runes to be transformed.
 // - options: A variadic list of HyphenMinusOption to customize the transformation.
 //
 // Returns:
@@ -47,10 +66,10 @@ func Underscore(runes []rune, options ...HyphenMinusOption) []rune {
 		option(params)
 	}
 
-	for _, r := range runes {
+	for i, r := range runes {
 		if unicode.IsLetter(r) {
 			if unicode.IsUpper(r) {
-				if unicode.IsLetter(last) && unicode.IsLower(last) {
+				if unicode.IsLetter(last) && unicode.IsLower(last) || params.SplitAcronyms && endsAcronym(runes, i, last) {
 					sb = append(sb, '_')
 					if params.PreserveCase || params.Screaming {
 						sb = append(sb, r)
@@ -139,10 +158,10 @@ func Dasherize(runes []rune, options ...HyphenMinusOption) []rune {
 		option(params)
 	}
 
-	for _, r := range runes {
+	for i, r := range runes {
 		if unicode.IsLetter(r) {
 			if unicode.IsUpper(r) {
-				if unicode.IsLetter(last) && unicode.IsLower(last) {
+				if unicode.IsLetter(last) && unicode.IsLower(last) || params.SplitAcronyms && endsAcronym(runes, i, last) {
 					sb = append(sb, '-')
 					if params.PreserveCase || params.Screaming {
 						sb = append(sb, r)
@@ -224,9 +243,9 @@ func CamelCase(runes []rune) []rune {
 
 	sb := make([]rune, 0)
 	last := rune(0)
-	for i, r := range runes {
+	for _, r := range runes {
 		if unicode.IsLetter(r) {
-			if i == 0 {
+			if len(sb) == 0 {
 				sb = append(sb, unicode.ToLower(r))
 				last = r
 				continue
@@ -238,18 +257,14 @@ func CamelCase(runes []rune) []rune {
 				continue
 			}
 
-			if unicode.IsUpper(r) {
-				if unicode.IsUpper(last) {
-					sb = append(sb, unicode.ToLower(r))
-					last = r
-					continue
-				}
-
-				sb = append(sb, r)
+			if unicode.IsUpper(r) && unicode.IsUpper(last) {
+				sb = append(sb, unicode.ToLower(r))
+				last = r
+				continue
 			}
 
 			sb = append(sb, r)
-
+			last = r
 			continue
 		}
 
@@ -280,9 +295,9 @@ func PascalCase(runes []rune) []rune {
 
 	sb := make([]rune, 0)
 	last := rune(0)
-	for i, r := range runes {
+	for _, r := range runes {
 		if unicode.IsLetter(r) {
-			if i == 0 {
+			if len(sb) == 0 {
 				sb = append(sb, unicode.ToUpper(r))
 				last = r
 				continue
@@ -294,18 +309,14 @@ func PascalCase(runes []rune) []rune {
 				continue
 			}
 
-			if unicode.IsUpper(r) {
-				if unicode.IsUpper(last) {
-					sb = append(sb, unicode.ToLower(r))
-					last = r
-					continue
-				}
-
-				sb = append(sb, r)
+			if unicode.IsUpper(r) && unicode.IsUpper(last) {
+				sb = append(sb, unicode.ToLower(r))
+				last = r
+				continue
 			}
 
 			sb = append(sb, r)
-
+			last = r
 			continue
 		}
 
@@ -320,3 +331,33 @@ func PascalCase(runes []rune) []rune {
 
 	return sb
 }
+
+// isWordStart reports whether the rune at index i of s starts a new word
+// according to the same boundary rules used by Underscore and Dasherize:
+// the first rune, a rune following a separator ('_', '-', whitespace or any
+// other rune that is neither a letter nor a number), and an uppercase letter
+// following a lowercase letter (camel humps).
+func isWordStart(s []rune, i int) bool {
+	r := s[i]
+	if !unicode.IsLetter(r) && !unicode.IsNumber(r) {
+		return false
+	}
+
+	if i == 0 {
+		return true
+	}
+
+	last := s[i-1]
+	if !unicode.IsLetter(last) && !unicode.IsNumber(last) {
+		return true
+	}
+
+	return unicode.IsUpper(r) && unicode.IsLower(last)
+}
+
+// endsAcronym reports whether the uppercase rune at index i of s starts a new
+// word after a run of uppercase letters, as the "S" in "HTTPServer" does: the
+// previous rune is uppercase and the next one is a lowercase letter.
+func endsAcronym(s []rune, i int, last rune) bool {
+	return unicode.IsUpper(last) && i+1 < len(s) && unicode.IsLower(s[i+1])
+}
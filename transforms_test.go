@@ -60,6 +60,24 @@ func TestUnderscore(t *testing.T) {
 			options:  nil,
 			expected: []rune("hello_world"),
 		},
+		{
+			name:     "Acronyms",
+			input:    []rune("HTTPServerURL"),
+			options:  nil,
+			expected: []rune("httpserver_url"),
+		},
+		{
+			name:     "Split acronyms",
+			input:    []rune("HTTPServerURL"),
+			options:  []HyphenMinusOption{SplitAcronyms},
+			expected: []rune("http_server_url"),
+		},
+		{
+			name:     "Split acronyms screaming",
+			input:    []rune("parseHTTPRequest"),
+			options:  []HyphenMinusOption{SplitAcronyms, Screaming},
+			expected: []rune("PARSE_HTTP_REQUEST"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,6 +145,18 @@ func TestDasherize(t *testing.T) {
 			options:  nil,
 			expected: []rune("hello-world"),
 		},
+		{
+			name:     "Acronyms",
+			input:    []rune("XMLHttpRequest"),
+			options:  nil,
+			expected: []rune("xmlhttp-request"),
+		},
+		{
+			name:     "Split acronyms",
+			input:    []rune("XMLHttpRequest"),
+			options:  []HyphenMinusOption{SplitAcronyms},
+			expected: []rune("xml-http-request"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +208,26 @@ func TestCamelCase(t *testing.T) {
 			input:    []rune("Hello World "),
 			expected: []rune("helloWorld"),
 		},
+		{
+			name:     "Camel humps",
+			input:    []rune("UserName"),
+			expected: []rune("userName"),
+		},
+		{
+			name:     "Already camel case",
+			input:    []rune("userName"),
+			expected: []rune("userName"),
+		},
+		{
+			name:     "Leading separator",
+			input:    []rune("_user_name"),
+			expected: []rune("userName"),
+		},
+		{
+			name:     "Acronyms",
+			input:    []rune("HTTPServer"),
+			expected: []rune("httpserver"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -229,6 +279,21 @@ func TestPascalCase(t *testing.T) {
 			input:    []rune("Hello World "),
 			expected: []rune("HelloWorld"),
 		},
+		{
+			name:     "Camel humps",
+			input:    []rune("userName"),
+			expected: []rune("UserName"),
+		},
+		{
+			name:     "Snake case",
+			input:    []rune("user_name"),
+			expected: []rune("UserName"),
+		},
+		{
+			name:     "Acronyms",
+			input:    []rune("XMLHttpRequest"),
+			expected: []rune("XmlhttpRequest"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -240,3 +305,29 @@ func TestPascalCase(t *testing.T) {
 		})
 	}
 }
+
+func TestLeadingSeparators(t *testing.T) {
+	tests := []struct {
+		name      string
+		transform func([]rune) []rune
+		input     string
+		expected  string
+	}{
+		{"CamelCase underscore", CamelCase, "_user_name", "userName"},
+		{"CamelCase spaces", CamelCase, "  Hello World", "helloWorld"},
+		{"CamelCase dashes", CamelCase, "--max-conns", "maxConns"},
+		{"CamelCase dunder", CamelCase, "__init__", "init"},
+		{"PascalCase underscore", PascalCase, "_user_name", "UserName"},
+		{"PascalCase space", PascalCase, " hello", "Hello"},
+		{"PascalCase dunder", PascalCase, "__init__", "Init"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.transform([]rune(tt.input))
+			if string(result) != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, string(result))
+			}
+		})
+	}
+}
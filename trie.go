@@ -0,0 +1,241 @@
+package xrunes
+
+import (
+	"iter"
+	"slices"
+)
+
+// TrieParams defines the parameters used by a Trie.
+type TrieParams struct {
+	// Fold compares keys using Unicode case-folding, the same way EqualFold does.
+	Fold bool
+}
+
+// TrieOption is a function type that modifies the options for TrieParams.
+type TrieOption func(params *TrieParams)
+
+// TrieFold sets the Fold field of the given TrieParams to true.
+func TrieFold(params *TrieParams) {
+	params.Fold = true
+}
+
+// Trie is a prefix tree keyed by slices of runes, suited for autocompletion of
+// command and flag names. With the TrieFold option keys are compared using
+// Unicode case-folding and the spelling used when a key was first inserted is
+// preserved.
+//
+// A Trie is not safe for concurrent use while it is being modified.
+type Trie[V any] struct {
+	params TrieParams
+	root   trieNode[V]
+	size   int
+}
+
+type trieNode[V any] struct {
+	r        rune
+	children []*trieNode[V]
+	key      []rune
+	value    V
+	terminal bool
+}
+
+// NewTrie creates an empty Trie.
+//
+// Example:
+//
+//	t := NewTrie[int](TrieFold)
+//	t.Insert([]rune("--verbose"), 1)
+//	t.Insert([]rune("--version"), 2)
+//	for key := range t.Completions([]rune("--VER")) {
+//		// key will be "--verbose" and then "--version"
+//	}
+func NewTrie[V any](options ...TrieOption) *Trie[V] {
+	t := &Trie[V]{}
+	for _, option := range options {
+		option(&t.params)
+	}
+
+	return t
+}
+
+// Len returns the number of keys in the trie.
+func (t *Trie[V]) Len() int {
+	return t.size
+}
+
+// Insert stores value for key, replacing the value of an existing key.
+func (t *Trie[V]) Insert(key []rune, value V) {
+	node := &t.root
+	for _, r := range key {
+		r = t.norm(r)
+		i, ok := slices.BinarySearchFunc(node.children, r, compareTrieNode[V])
+		if !ok {
+			node.children = slices.Insert(node.children, i, &trieNode[V]{r: r})
+		}
+
+		node = node.children[i]
+	}
+
+	if !node.terminal {
+		node.terminal = true
+		node.key = slices.Clone(key)
+		t.size++
+	}
+
+	node.value = value
+}
+
+// Get returns the value stored for key and whether it was found.
+func (t *Trie[V]) Get(key []rune) (V, bool) {
+	node := t.find(key)
+	if node == nil || !node.terminal {
+		var zero V
+		return zero, false
+	}
+
+	return node.value, true
+}
+
+// Delete removes key from the trie and reports whether it was present.
+func (t *Trie[V]) Delete(key []rune) bool {
+	path := make([]*trieNode[V], 0, len(key)+1)
+	node := &t.root
+	path = append(path, node)
+	for _, r := range key {
+		node = node.child(t.norm(r))
+		if node == nil {
+			return false
+		}
+
+		path = append(path, node)
+	}
+
+	if !node.terminal {
+		return false
+	}
+
+	var zero V
+	node.terminal = false
+	node.key = nil
+	node.value = zero
+	t.size--
+
+	// prune the branches that no longer lead to a key.
+	for i := len(path) - 1; i > 0; i-- {
+		n := path[i]
+		if n.terminal || len(n.children) > 0 {
+			break
+		}
+
+		parent := path[i-1]
+		j, _ := slices.BinarySearchFunc(parent.children, n.r, compareTrieNode[V])
+		parent.children = slices.Delete(parent.children, j, j+1)
+	}
+
+	return true
+}
+
+// LongestPrefix returns the longest key in the trie that is a prefix of s,
+// as a sub-slice of s, with its value and whether such a key was found.
+//
+// Example:
+//
+//	t := NewTrie[string]()
+//	t.Insert([]rune("/api"), "api")
+//	t.Insert([]rune("/api/users"), "users")
+//	p, v, _ := t.LongestPrefix([]rune("/api/users/42")) // p will be "/api/users", v "users"
+func (t *Trie[V]) LongestPrefix(s []rune) ([]rune, V, bool) {
+	var value V
+	found := false
+	n := 0
+	node := &t.root
+	if node.terminal {
+		value = node.value
+		found = true
+	}
+
+	for i, r := range s {
+		node = node.child(t.norm(r))
+		if node == nil {
+			break
+		}
+
+		if node.terminal {
+			value = node.value
+			found = true
+			n = i + 1
+		}
+	}
+
+	if !found {
+		return nil, value, false
+	}
+
+	return s[:n], value, true
+}
+
+// Completions returns an iterator over every key starting with prefix, and its
+// value, in sorted order. Keys are yielded with the spelling they were inserted
+// with. An empty prefix iterates over the whole trie.
+func (t *Trie[V]) Completions(prefix []rune) iter.Seq2[[]rune, V] {
+	return func(yield func([]rune, V) bool) {
+		node := t.find(prefix)
+		if node == nil {
+			return
+		}
+
+		node.walk(yield)
+	}
+}
+
+// All returns an iterator over every key of the trie and its value, in sorted order.
+func (t *Trie[V]) All() iter.Seq2[[]rune, V] {
+	return t.Completions(nil)
+}
+
+func (t *Trie[V]) find(key []rune) *trieNode[V] {
+	node := &t.root
+	for _, r := range key {
+		node = node.child(t.norm(r))
+		if node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func (t *Trie[V]) norm(r rune) rune {
+	if t.params.Fold {
+		return foldRune(r)
+	}
+
+	return r
+}
+
+func (n *trieNode[V]) child(r rune) *trieNode[V] {
+	i, ok := slices.BinarySearchFunc(n.children, r, compareTrieNode[V])
+	if !ok {
+		return nil
+	}
+
+	return n.children[i]
+}
+
+func (n *trieNode[V]) walk(yield func([]rune, V) bool) bool {
+	if n.terminal && !yield(n.key, n.value) {
+		return false
+	}
+
+	for _, c := range n.children {
+		if !c.walk(yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func compareTrieNode[V any](n *trieNode[V], r rune) int {
+	return int(n.r - r)
+}
@@ -0,0 +1,119 @@
+package xrunes_test
+
+import (
+	"testing"
+
+	runes "github.com/jolt9dev/go-xrunes"
+	"github.com/stretchr/testify/assert"
+)
+
+func completions[V any](t *runes.Trie[V], prefix string) []string {
+	keys := make([]string, 0)
+	for k := range t.Completions([]rune(prefix)) {
+		keys = append(keys, string(k))
+	}
+
+	return keys
+}
+
+func TestTrie(t *testing.T) {
+	trie := runes.NewTrie[int]()
+	for i, name := range []string{"status", "stash", "commit", "checkout", "cherry-pick", "clone", "st"} {
+		trie.Insert([]rune(name), i)
+	}
+
+	assert.Equal(t, 7, trie.Len())
+
+	v, ok := trie.Get([]rune("stash"))
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	_, ok = trie.Get([]rune("sta"))
+	assert.False(t, ok)
+
+	_, ok = trie.Get([]rune("STASH"))
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{"checkout", "cherry-pick"}, completions(trie, "che"))
+	assert.Equal(t, []string{"st", "stash", "status"}, completions(trie, "st"))
+	assert.Empty(t, completions(trie, "x"))
+	assert.Equal(t, []string{"checkout", "cherry-pick", "clone", "commit", "st", "stash", "status"}, completions(trie, ""))
+
+	trie.Insert([]rune("stash"), 42)
+	assert.Equal(t, 7, trie.Len())
+	v, _ = trie.Get([]rune("stash"))
+	assert.Equal(t, 42, v)
+}
+
+func TestTrieLongestPrefix(t *testing.T) {
+	trie := runes.NewTrie[string]()
+	trie.Insert([]rune("/api"), "api")
+	trie.Insert([]rune("/api/users"), "users")
+
+	p, v, ok := trie.LongestPrefix([]rune("/api/users/42"))
+	assert.True(t, ok)
+	assert.Equal(t, "/api/users", string(p))
+	assert.Equal(t, "users", v)
+
+	p, v, ok = trie.LongestPrefix([]rune("/api/groups"))
+	assert.True(t, ok)
+	assert.Equal(t, "/api", string(p))
+	assert.Equal(t, "api", v)
+
+	_, _, ok = trie.LongestPrefix([]rune("/other"))
+	assert.False(t, ok)
+
+	trie.Insert(nil, "root")
+	p, v, ok = trie.LongestPrefix([]rune("/other"))
+	assert.True(t, ok)
+	assert.Empty(t, p)
+	assert.Equal(t, "root", v)
+}
+
+func TestTrieFold(t *testing.T) {
+	trie := runes.NewTrie[int](runes.TrieFold)
+	trie.Insert([]rune("--Verbose"), 1)
+	trie.Insert([]rune("--version"), 2)
+	trie.Insert([]rune("--VERBOSE"), 3)
+
+	assert.Equal(t, 2, trie.Len())
+	assert.Equal(t, []string{"--Verbose", "--version"}, completions(trie, "--VER"))
+
+	v, ok := trie.Get([]rune("--verbose"))
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+
+	p, _, ok := trie.LongestPrefix([]rune("--VERSION=2"))
+	assert.True(t, ok)
+	assert.Equal(t, "--VERSION", string(p))
+}
+
+func TestTrieDelete(t *testing.T) {
+	trie := runes.NewTrie[int]()
+	trie.Insert([]rune("ab"), 1)
+	trie.Insert([]rune("abc"), 2)
+
+	assert.False(t, trie.Delete([]rune("a")))
+	assert.False(t, trie.Delete([]rune("abcd")))
+	assert.True(t, trie.Delete([]rune("abc")))
+	assert.False(t, trie.Delete([]rune("abc")))
+	assert.Equal(t, 1, trie.Len())
+	assert.Equal(t, []string{"ab"}, completions(trie, ""))
+
+	assert.True(t, trie.Delete([]rune("ab")))
+	assert.Empty(t, completions(trie, ""))
+}
+
+func TestTrieAllStopsEarly(t *testing.T) {
+	trie := runes.NewTrie[int]()
+	trie.Insert([]rune("a"), 1)
+	trie.Insert([]rune("b"), 2)
+
+	n := 0
+	for range trie.All() {
+		n++
+		break
+	}
+
+	assert.Equal(t, 1, n)
+}